@@ -0,0 +1,88 @@
+package comicinfo
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"testing"
+)
+
+func BenchmarkEncodeV2(b *testing.B) {
+	ci := newTestComicInfov2()
+	b.ReportAllocs()
+	for b.Loop() {
+		if err := ci.Encode(io.Discard); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecodeV2(b *testing.B) {
+	var encoded bytes.Buffer
+	if err := newTestComicInfov2().Encode(&encoded); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	for b.Loop() {
+		var ci ComicInfov2
+		if err := xml.Unmarshal(encoded.Bytes(), &ci); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkValidateV2(b *testing.B) {
+	ci := newTestComicInfov2()
+	b.ReportAllocs()
+	for b.Loop() {
+		if err := ci.Validate(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkEncodeV21(b *testing.B) {
+	ci := newTestComicInfov21()
+	b.ReportAllocs()
+	for b.Loop() {
+		if err := ci.Encode(io.Discard); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecodeV21(b *testing.B) {
+	var encoded bytes.Buffer
+	if err := newTestComicInfov21().Encode(&encoded); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	for b.Loop() {
+		var ci ComicInfov21
+		if err := xml.Unmarshal(encoded.Bytes(), &ci); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkValidate500Pages(b *testing.B) {
+	ci := newTestComicInfov2()
+	ci.Pages = newTestPagesV2(500)
+	ci.PageCount = 500
+	for _, bench := range []struct {
+		name string
+		opts ValidationOptions
+	}{
+		{"Sequential", ValidationOptions{}},
+		{"Parallel", ValidationOptions{ParallelPageValidation: true}},
+	} {
+		b.Run(bench.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				if err := ci.ValidateWithOptions(bench.opts); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
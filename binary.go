@@ -0,0 +1,144 @@
+package comicinfo
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+)
+
+const (
+	binaryFormatVersion = 1
+)
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface using a compact binary representation.
+// It is meant for in-process caching: use Encode() to produce an actual ComicInfo.xml file.
+// The format follows the struct fields order and is therefore only stable for a given version of this package.
+func (ci ComicInfov2) MarshalBinary() ([]byte, error) {
+	data := []byte{binaryFormatVersion}
+	return appendBinaryValue(data, reflect.ValueOf(ci))
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface, decoding data produced by MarshalBinary.
+func (ci *ComicInfov2) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 || data[0] != binaryFormatVersion {
+		return errors.New("unsupported binary format version")
+	}
+	var decoded ComicInfov2
+	rest, err := readBinaryValue(data[1:], reflect.ValueOf(&decoded).Elem())
+	if err != nil {
+		return fmt.Errorf("failed to decode ComicInfo v2: %w", err)
+	}
+	if len(rest) != 0 {
+		return fmt.Errorf("failed to decode ComicInfo v2: %d trailing bytes", len(rest))
+	}
+	*ci = decoded
+	return nil
+}
+
+func appendBinaryValue(data []byte, v reflect.Value) ([]byte, error) {
+	var err error
+	switch v.Kind() {
+	case reflect.String:
+		data = binary.AppendUvarint(data, uint64(v.Len()))
+		data = append(data, v.String()...)
+	case reflect.Int:
+		data = binary.AppendVarint(data, v.Int())
+	case reflect.Bool:
+		if v.Bool() {
+			data = append(data, 1)
+		} else {
+			data = append(data, 0)
+		}
+	case reflect.Float64:
+		data = binary.LittleEndian.AppendUint64(data, math.Float64bits(v.Float()))
+	case reflect.Pointer:
+		if v.IsNil() {
+			return append(data, 0), nil
+		}
+		return appendBinaryValue(append(data, 1), v.Elem())
+	case reflect.Slice:
+		data = binary.AppendUvarint(data, uint64(v.Len()))
+		for i := range v.Len() {
+			if data, err = appendBinaryValue(data, v.Index(i)); err != nil {
+				return nil, err
+			}
+		}
+	case reflect.Struct:
+		for i := range v.NumField() {
+			if data, err = appendBinaryValue(data, v.Field(i)); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported kind %s", v.Kind())
+	}
+	return data, nil
+}
+
+func readBinaryValue(data []byte, v reflect.Value) ([]byte, error) {
+	var err error
+	switch v.Kind() {
+	case reflect.String:
+		length, n := binary.Uvarint(data)
+		if n <= 0 || uint64(len(data)-n) < length {
+			return nil, errors.New("invalid string")
+		}
+		v.SetString(string(data[n : n+int(length)]))
+		return data[n+int(length):], nil
+	case reflect.Int:
+		value, n := binary.Varint(data)
+		if n <= 0 {
+			return nil, errors.New("invalid integer")
+		}
+		v.SetInt(value)
+		return data[n:], nil
+	case reflect.Bool:
+		if len(data) < 1 || data[0] > 1 {
+			return nil, errors.New("invalid boolean")
+		}
+		v.SetBool(data[0] == 1)
+		return data[1:], nil
+	case reflect.Float64:
+		if len(data) < 8 {
+			return nil, errors.New("invalid float")
+		}
+		v.SetFloat(math.Float64frombits(binary.LittleEndian.Uint64(data)))
+		return data[8:], nil
+	case reflect.Pointer:
+		if len(data) < 1 || data[0] > 1 {
+			return nil, errors.New("invalid pointer marker")
+		}
+		if data[0] == 0 {
+			return data[1:], nil
+		}
+		v.Set(reflect.New(v.Type().Elem()))
+		return readBinaryValue(data[1:], v.Elem())
+	case reflect.Slice:
+		length, n := binary.Uvarint(data)
+		if n <= 0 || length > uint64(len(data)-n) { // each element takes at least one byte
+			return nil, errors.New("invalid slice length")
+		}
+		data = data[n:]
+		if length == 0 {
+			return data, nil
+		}
+		v.Set(reflect.MakeSlice(v.Type(), int(length), int(length)))
+		for i := range int(length) {
+			if data, err = readBinaryValue(data, v.Index(i)); err != nil {
+				return nil, err
+			}
+		}
+		return data, nil
+	case reflect.Struct:
+		for i := range v.NumField() {
+			if data, err = readBinaryValue(data, v.Field(i)); err != nil {
+				return nil, fmt.Errorf("%s: %w", v.Type().Field(i).Name, err)
+			}
+		}
+		return data, nil
+	default:
+		return nil, fmt.Errorf("unsupported kind %s", v.Kind())
+	}
+}
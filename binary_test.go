@@ -0,0 +1,80 @@
+package comicinfo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBinaryRoundTrip(t *testing.T) {
+	ci := newTestComicInfov2()
+	ci.IssueNumberStr = "12.5"
+	ci.WordCount = 4200
+	rating := 3.5
+	ci.Rating = &rating
+	data, err := ci.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary failed: %s", err)
+	}
+	var decoded ComicInfov2
+	if err = decoded.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary failed: %s", err)
+	}
+	if !reflect.DeepEqual(ci, decoded) {
+		t.Fatalf("round trip mismatch:\n%+v\n---\n%+v", ci, decoded)
+	}
+	if decoded.CommunityRating == ci.CommunityRating || decoded.Rating == ci.Rating {
+		t.Error("decoded pointers must not alias the original values")
+	}
+}
+
+func TestBinaryRoundTripNilPointers(t *testing.T) {
+	ci := newTestComicInfov2()
+	ci.CommunityRating = nil
+	ci.Pages = PagesV2{}
+	data, err := ci.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary failed: %s", err)
+	}
+	var decoded ComicInfov2
+	if err = decoded.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary failed: %s", err)
+	}
+	if decoded.CommunityRating != nil || decoded.Rating != nil {
+		t.Error("nil pointers must decode as nil")
+	}
+	if len(decoded.Pages.Pages) != 0 {
+		t.Errorf("expected no pages, got %d", len(decoded.Pages.Pages))
+	}
+}
+
+func TestBinaryTruncated(t *testing.T) {
+	data, err := newTestComicInfov2().MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary failed: %s", err)
+	}
+	for _, length := range []int{0, 1, 2, len(data) / 2, len(data) - 1} {
+		var decoded ComicInfov2
+		if err = decoded.UnmarshalBinary(data[:length]); err == nil {
+			t.Errorf("expected an error for input truncated to %d/%d bytes", length, len(data))
+		}
+	}
+	var decoded ComicInfov2
+	if err = decoded.UnmarshalBinary(append(data, 0)); err == nil {
+		t.Error("expected an error for trailing bytes")
+	}
+}
+
+func BenchmarkBinaryRoundTrip(b *testing.B) {
+	ci := newTestComicInfov2()
+	b.ReportAllocs()
+	for b.Loop() {
+		data, err := ci.MarshalBinary()
+		if err != nil {
+			b.Fatal(err)
+		}
+		var decoded ComicInfov2
+		if err = decoded.UnmarshalBinary(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
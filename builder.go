@@ -0,0 +1,58 @@
+package comicinfo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ComicInfov21Builder allows to set the v2.1 specific fields of a ComicInfov21 with chainable setters.
+// Common fields can be set on the base struct given to NewComicInfov21Builder().
+type ComicInfov21Builder struct {
+	ci ComicInfov21
+}
+
+// NewComicInfov21Builder returns a builder starting from base. Use NewComicInfov21() as base to get explicit unknown defaults.
+func NewComicInfov21Builder(base ComicInfov21) *ComicInfov21Builder {
+	return &ComicInfov21Builder{
+		ci: base,
+	}
+}
+
+// Tags sets the Tags field.
+func (b *ComicInfov21Builder) Tags(tags ...string) *ComicInfov21Builder {
+	b.ci.SetTags(tags)
+	return b
+}
+
+// Translator sets the Translator field.
+func (b *ComicInfov21Builder) Translator(names ...string) *ComicInfov21Builder {
+	b.ci.Translator = FormatCommaField(names)
+	return b
+}
+
+// GTIN sets the GTIN field. Its validity is checked by Build().
+func (b *ComicInfov21Builder) GTIN(gtin string) *ComicInfov21Builder {
+	b.ci.GTIN = strings.TrimSpace(gtin)
+	return b
+}
+
+// StoryArcPairs sets both the StoryArc and StoryArcNumber fields.
+func (b *ComicInfov21Builder) StoryArcPairs(pairs ...StoryArcPair) *ComicInfov21Builder {
+	b.ci.SetStoryArcPairs(pairs)
+	return b
+}
+
+// Build checks that StoryArc and StoryArcNumber have the same number of values and validates the resulting ComicInfov21.
+func (b *ComicInfov21Builder) Build() (ComicInfov21, error) {
+	if b.ci.StoryArcNumber != "" {
+		arcs := len(strings.Split(b.ci.StoryArc, ","))
+		numbers := len(strings.Split(b.ci.StoryArcNumber, ","))
+		if arcs != numbers {
+			return ComicInfov21{}, fmt.Errorf("StoryArc has %d values but StoryArcNumber has %d", arcs, numbers)
+		}
+	}
+	if err := b.ci.Validate(); err != nil {
+		return ComicInfov21{}, fmt.Errorf("validation failed: %w", err)
+	}
+	return b.ci, nil
+}
@@ -0,0 +1,222 @@
+package comicinfo
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	_ "image/gif"  // register GIF for AutoPopulatePageInfo
+	_ "image/jpeg" // register JPEG for AutoPopulatePageInfo
+	_ "image/png"  // register PNG for AutoPopulatePageInfo
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ErrComicInfoNotFound is returned by ComicArchiveReader.FindComicInfo when the archive has no ComicInfo.xml entry.
+var ErrComicInfoNotFound = errors.New("ComicInfo.xml not found in archive")
+
+// ComicArchiveReader abstracts the comic book archive formats. CBZReader implements it for zip archives, other formats
+// (eg. CBR, which are RAR archives) can be supported by implementing it on top of a third-party library.
+type ComicArchiveReader interface {
+	// FindComicInfo returns the content of the ComicInfo.xml entry, or ErrComicInfoNotFound.
+	FindComicInfo() (io.ReadCloser, error)
+	// ListImages returns the names of the image entries, sorted by name.
+	ListImages() []string
+}
+
+// ReadComicInfo decodes and validates the ComicInfo of archive. The decoded ComicInfo is returned along with validation errors.
+func ReadComicInfo(archive ComicArchiveReader) (ci ComicInfov2, err error) {
+	if archive == nil {
+		return ci, errors.New("archive cannot be nil")
+	}
+	content, err := archive.FindComicInfo()
+	if err != nil {
+		return ci, fmt.Errorf("failed to find ComicInfo: %w", err)
+	}
+	defer content.Close()
+	if err = xml.NewDecoder(content).Decode(&ci); err != nil {
+		return ComicInfov2{}, fmt.Errorf("failed to decode ComicInfo: %w", err)
+	}
+	if err = ci.Validate(); err != nil {
+		return ci, fmt.Errorf("validation failed: %w", err)
+	}
+	return
+}
+
+// CBZReader gives access to the ComicInfo of a CBZ (zip) archive stored on disk. It implements ComicArchiveReader.
+type CBZReader struct {
+	path string
+}
+
+// OpenCBZ returns a CBZReader for the archive at path after checking it is a valid zip file.
+func OpenCBZ(path string) (*CBZReader, error) {
+	archive, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open CBZ archive: %w", err)
+	}
+	if err = archive.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close CBZ archive: %w", err)
+	}
+	return &CBZReader{path: path}, nil
+}
+
+// FindComicInfo implements ComicArchiveReader. The returned ReadCloser must be closed to release the archive.
+func (r *CBZReader) FindComicInfo() (io.ReadCloser, error) {
+	archive, err := zip.OpenReader(r.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open CBZ archive: %w", err)
+	}
+	for _, file := range archive.File {
+		if !isComicInfoEntry(file.Name) {
+			continue
+		}
+		entry, err := file.Open()
+		if err != nil {
+			archive.Close()
+			return nil, fmt.Errorf("failed to open %q: %w", file.Name, err)
+		}
+		return cbzEntry{ReadCloser: entry, archive: archive}, nil
+	}
+	archive.Close()
+	return nil, ErrComicInfoNotFound
+}
+
+// ListImages implements ComicArchiveReader. It returns nil if the archive can not be read.
+func (r *CBZReader) ListImages() (images []string) {
+	archive, err := zip.OpenReader(r.path)
+	if err != nil {
+		return nil
+	}
+	defer archive.Close()
+	for _, file := range archive.File {
+		if !file.FileInfo().IsDir() && isImageEntry(file.Name) {
+			images = append(images, file.Name)
+		}
+	}
+	slices.Sort(images)
+	return
+}
+
+// CBZBuilder helps building the ComicInfo of a new CBZ archive from its raw images.
+type CBZBuilder struct {
+	ComicInfo ComicInfov2
+}
+
+// NewCBZBuilder returns a CBZBuilder starting from ci.
+func NewCBZBuilder(ci ComicInfov2) *CBZBuilder {
+	return &CBZBuilder{ComicInfo: ci}
+}
+
+// AutoPopulatePageInfo sets the ImageWidth, ImageHeight and ImageSize of the page at position pageIndex from its raw image data.
+// JPEG, PNG and GIF images are supported, WebP requires registering golang.org/x/image/webp. See PagesV2.AutoPopulatePageInfo.
+func (b *CBZBuilder) AutoPopulatePageInfo(data []byte, pageIndex int) error {
+	return b.ComicInfo.Pages.AutoPopulatePageInfo(data, pageIndex)
+}
+
+// cbzEntry is an archive entry which closes its archive along with itself.
+type cbzEntry struct {
+	io.ReadCloser
+	archive *zip.ReadCloser
+}
+
+func (e cbzEntry) Close() error {
+	return errors.Join(e.ReadCloser.Close(), e.archive.Close())
+}
+
+// UpdateComicInfoV2 replaces (or adds) the ComicInfo.xml entry of the archive with ci, the other entries being copied as is.
+// As zip archives can not be updated in place, a new archive is written next to the original one and renamed over it:
+// the original archive is left untouched if an error occurs. An error is returned if the archive is read-only.
+func (r *CBZReader) UpdateComicInfoV2(ci ComicInfov2) (err error) {
+	info, err := os.Stat(r.path)
+	if err != nil {
+		return fmt.Errorf("failed to stat CBZ archive: %w", err)
+	}
+	if info.Mode().Perm()&0o200 == 0 {
+		return errors.New("CBZ archive is read-only")
+	}
+	// Encode first to fail early on invalid metadata
+	var encoded bytes.Buffer
+	if err = ci.Encode(&encoded); err != nil {
+		return fmt.Errorf("failed to encode ComicInfo: %w", err)
+	}
+	// Write the updated archive to a temporary file within the same directory, for the rename to be atomic
+	tmp, err := os.CreateTemp(filepath.Dir(r.path), "."+filepath.Base(r.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary archive: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			tmp.Close()
+			os.Remove(tmp.Name())
+		}
+	}()
+	if err = r.writeUpdated(tmp, encoded.Bytes()); err != nil {
+		return
+	}
+	if err = tmp.Sync(); err != nil {
+		return fmt.Errorf("failed to sync temporary archive: %w", err)
+	}
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close temporary archive: %w", err)
+	}
+	if err = os.Chmod(tmp.Name(), info.Mode().Perm()); err != nil {
+		return fmt.Errorf("failed to set temporary archive permissions: %w", err)
+	}
+	if err = os.Rename(tmp.Name(), r.path); err != nil {
+		return fmt.Errorf("failed to replace CBZ archive: %w", err)
+	}
+	return
+}
+
+// writeUpdated writes to output a copy of the archive where the ComicInfo.xml entry is replaced by comicInfo.
+func (r *CBZReader) writeUpdated(output *os.File, comicInfo []byte) (err error) {
+	source, err := zip.OpenReader(r.path)
+	if err != nil {
+		return fmt.Errorf("failed to open CBZ archive: %w", err)
+	}
+	defer source.Close()
+	archive := zip.NewWriter(output)
+	for _, file := range source.File {
+		if isComicInfoEntry(file.Name) {
+			continue
+		}
+		if err = archive.Copy(file); err != nil {
+			return fmt.Errorf("failed to copy %q: %w", file.Name, err)
+		}
+	}
+	entry, err := archive.CreateHeader(&zip.FileHeader{
+		Name:     ComicInfoFileName,
+		Method:   zip.Deflate,
+		Modified: time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create %s entry: %w", ComicInfoFileName, err)
+	}
+	if _, err = entry.Write(comicInfo); err != nil {
+		return fmt.Errorf("failed to write %s entry: %w", ComicInfoFileName, err)
+	}
+	if err = archive.Close(); err != nil {
+		return fmt.Errorf("failed to finalize archive: %w", err)
+	}
+	return
+}
+
+// isComicInfoEntry returns true if the archive entry name is the ComicInfo.xml file, at the archive root (case-insensitive).
+func isComicInfoEntry(name string) bool {
+	return strings.EqualFold(name, ComicInfoFileName)
+}
+
+// isImageEntry returns true if the archive entry name has the extension of an image format used by comic books.
+func isImageEntry(name string) bool {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".jpg", ".jpeg", ".png", ".gif", ".webp", ".avif", ".bmp":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,35 @@
+package comicinfo
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"testing"
+)
+
+func TestCBZBuilderAutoPopulatePageInfo(t *testing.T) {
+	var data bytes.Buffer
+	if err := png.Encode(&data, image.NewGray(image.Rect(0, 0, 40, 60))); err != nil {
+		t.Fatalf("failed to encode test image: %s", err)
+	}
+	builder := NewCBZBuilder(ComicInfov2{Pages: PagesV2{Pages: []PageV2{
+		NewPageV2(0, "", PageTypeFrontCover),
+		NewPageV2(1, "", PageTypeStory),
+	}}})
+	if err := builder.AutoPopulatePageInfo(data.Bytes(), 1); err != nil {
+		t.Fatalf("AutoPopulatePageInfo failed: %s", err)
+	}
+	page := builder.ComicInfo.Pages.Pages[1]
+	if page.ImageWidth != 40 || page.ImageHeight != 60 || page.ImageSize != data.Len() {
+		t.Errorf("expected 40x60 (%d bytes), got %dx%d (%d bytes)", data.Len(), page.ImageWidth, page.ImageHeight, page.ImageSize)
+	}
+	if untouched := builder.ComicInfo.Pages.Pages[0]; untouched.ImageWidth != -1 || untouched.ImageHeight != -1 {
+		t.Error("other pages must not be modified")
+	}
+	if err := builder.AutoPopulatePageInfo(data.Bytes(), 2); err == nil {
+		t.Error("expected an error for an out of range page index")
+	}
+	if err := builder.AutoPopulatePageInfo([]byte("not an image"), 0); err == nil {
+		t.Error("expected an error for invalid image data")
+	}
+}
@@ -0,0 +1,78 @@
+// Package comicinfotest provides helpers to check that ComicInfo structs survive an encode/decode cycle.
+package comicinfotest
+
+import (
+	"bytes"
+	"encoding/xml"
+	"reflect"
+	"testing"
+
+	"github.com/hekmon/go-comicinfo"
+)
+
+// RoundTripV2 encodes ci to XML, decodes it back and reports every field which differs from the original.
+// As Encode() fills in PageCount when it is not set, the original is expected to have it synced.
+// Fields which are not part of the XML output are ignored.
+func RoundTripV2(t testing.TB, ci comicinfo.ComicInfov2) {
+	t.Helper()
+	var buffer bytes.Buffer
+	if err := ci.Encode(&buffer); err != nil {
+		t.Fatalf("failed to encode ComicInfo v2: %s", err)
+	}
+	var decoded comicinfo.ComicInfov2
+	if err := xml.Unmarshal(buffer.Bytes(), &decoded); err != nil {
+		t.Fatalf("failed to decode ComicInfo v2: %s", err)
+	}
+	if ci.PageCount == 0 && len(ci.Pages.Pages) > 0 {
+		ci.SyncPageCount()
+	}
+	compareFields(t, reflect.ValueOf(ci), reflect.ValueOf(decoded))
+}
+
+// RoundTripV21 encodes ci to XML, decodes it back and reports every field which differs from the original.
+// As Encode() fills in PageCount when it is not set, the original is expected to have it synced.
+func RoundTripV21(t testing.TB, ci comicinfo.ComicInfov21) {
+	t.Helper()
+	var buffer bytes.Buffer
+	if err := ci.Encode(&buffer); err != nil {
+		t.Fatalf("failed to encode ComicInfo v2.1: %s", err)
+	}
+	var decoded comicinfo.ComicInfov21
+	if err := xml.Unmarshal(buffer.Bytes(), &decoded); err != nil {
+		t.Fatalf("failed to decode ComicInfo v2.1: %s", err)
+	}
+	if ci.PageCount == 0 && len(ci.Pages.Pages) > 0 {
+		ci.SyncPageCount()
+	}
+	compareFields(t, reflect.ValueOf(ci), reflect.ValueOf(decoded))
+}
+
+func compareFields(t testing.TB, expected, got reflect.Value) {
+	t.Helper()
+	for i := range expected.NumField() {
+		if expected.Type().Field(i).Tag.Get("xml") == "-" {
+			continue // not part of the XML output
+		}
+		if !equal(expected.Field(i), got.Field(i)) {
+			t.Errorf("%s: expected %v, got %v", expected.Type().Field(i).Name, expected.Field(i), got.Field(i))
+		}
+	}
+}
+
+// equal is reflect.DeepEqual except that nil and empty slices are considered equal, as XML can not tell them apart.
+func equal(a, b reflect.Value) bool {
+	switch a.Kind() {
+	case reflect.Slice:
+		if a.Len() == 0 && b.Len() == 0 {
+			return true
+		}
+	case reflect.Struct:
+		for i := range a.NumField() {
+			if !equal(a.Field(i), b.Field(i)) {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(a.Interface(), b.Interface())
+}
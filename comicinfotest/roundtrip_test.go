@@ -0,0 +1,119 @@
+package comicinfotest
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hekmon/go-comicinfo"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+func newComicInfov2() comicinfo.ComicInfov2 {
+	rating := comicinfo.CommunityRating(4.5)
+	return comicinfo.ComicInfov2{
+		Title:       "The Last Stand",
+		Series:      "Example Saga",
+		Number:      12,
+		Year:        2021,
+		Month:       6,
+		Day:         15,
+		Writer:      "Jane Doe, John Smith",
+		Penciller:   "Alice Martin",
+		LanguageISO: "en",
+		Manga:       comicinfo.MangaNo,
+		AgeRating:   comicinfo.AgeRatingTeen,
+		Pages: comicinfo.PagesV2{Pages: []comicinfo.PageV2{
+			comicinfo.NewPageV2(0, "cover.jpg", comicinfo.PageTypeFrontCover),
+			comicinfo.NewPageV2(1, "page1.jpg", comicinfo.PageTypeStory),
+		}},
+		CommunityRating: &rating,
+	}
+}
+
+func newComicInfov21() comicinfo.ComicInfov21 {
+	rating := comicinfo.CommunityRatingV21(4.5)
+	return comicinfo.ComicInfov21{
+		Title:       "The Last Stand",
+		Series:      "Example Saga",
+		Number:      12,
+		Year:        2021,
+		Month:       6,
+		Day:         15,
+		Writer:      "Jane Doe, John Smith",
+		Translator:  "Grace Hopper",
+		LanguageISO: "en",
+		Manga:       comicinfo.MangaNo,
+		AgeRating:   comicinfo.AgeRatingTeen,
+		Pages: comicinfo.PagesV2{Pages: []comicinfo.PageV2{
+			comicinfo.NewPageV2(0, "cover.jpg", comicinfo.PageTypeFrontCover),
+			comicinfo.NewPageV2(1, "page1.jpg", comicinfo.PageTypeStory),
+		}},
+		CommunityRating: &rating,
+		GTIN:            "9780306406157",
+	}
+}
+
+func TestRoundTripV2(t *testing.T) {
+	tests := map[string]comicinfo.ComicInfov2{
+		"empty":  {},
+		"full":   newComicInfov2(),
+		"synced": func() comicinfo.ComicInfov2 { ci := newComicInfov2(); ci.SyncPageCount(); return ci }(),
+	}
+	for name, ci := range tests {
+		t.Run(name, func(t *testing.T) {
+			RoundTripV2(t, ci)
+		})
+	}
+}
+
+func TestRoundTripV21(t *testing.T) {
+	tests := map[string]comicinfo.ComicInfov21{
+		"empty": {},
+		"full":  newComicInfov21(),
+	}
+	for name, ci := range tests {
+		t.Run(name, func(t *testing.T) {
+			RoundTripV21(t, ci)
+		})
+	}
+}
+
+// TestGolden pins the encoded output of each spec version, including the root element name and the
+// injected xsi:schemaLocation attribute. Run with -update to regenerate the golden files.
+func TestGolden(t *testing.T) {
+	tests := []struct {
+		name   string
+		encode func(*bytes.Buffer) error
+	}{
+		{"v1", func(b *bytes.Buffer) error {
+			return comicinfo.ComicInfov1{Title: "The Last Stand", Series: "Example Saga", Number: 12, Year: 2021, Month: 6}.Encode(b)
+		}},
+		{"v2", func(b *bytes.Buffer) error { return newComicInfov2().Encode(b) }},
+		{"v2.1", func(b *bytes.Buffer) error { return newComicInfov21().Encode(b) }},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var got bytes.Buffer
+			if err := test.encode(&got); err != nil {
+				t.Fatalf("failed to encode: %s", err)
+			}
+			golden := filepath.Join("testdata", test.name+".golden")
+			if *update {
+				if err := os.WriteFile(golden, got.Bytes(), 0o644); err != nil {
+					t.Fatalf("failed to update golden file: %s", err)
+				}
+			}
+			expected, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("failed to read golden file: %s", err)
+			}
+			if !bytes.Equal(expected, got.Bytes()) {
+				t.Errorf("output differs from %s:\n%s", golden, got.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package comicinfo
+
+import (
+	"strings"
+)
+
+// ParseCommaField splits a comma separated field (eg. Writer, Genre, Characters) into its values.
+// Whitespace around each value is trimmed and empty values are dropped.
+func ParseCommaField(field string) (values []string) {
+	for _, value := range strings.Split(field, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return
+}
+
+// FormatCommaField joins values into a comma separated field, using ", " as separator.
+// Whitespace around each value is trimmed and empty values are dropped.
+// Values containing a comma are not supported as the spec does not define any escaping mechanism.
+func FormatCommaField(values []string) string {
+	cleaned := make([]string, 0, len(values))
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			cleaned = append(cleaned, value)
+		}
+	}
+	return strings.Join(cleaned, ", ")
+}
+
+// NormalizeCommaField returns the canonical form of a comma separated field, eg. " John Romita,Stan Lee " becomes "John Romita, Stan Lee".
+func NormalizeCommaField(field string) string {
+	return FormatCommaField(ParseCommaField(field))
+}
+
+// hasCommaValue returns true if value is present in the comma separated field (case-insensitive).
+func hasCommaValue(field, value string) bool {
+	value = strings.TrimSpace(value)
+	for _, existing := range ParseCommaField(field) {
+		if strings.EqualFold(existing, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// addCommaValue appends value to the comma separated field if not already present (case-insensitive).
+func addCommaValue(field *string, value string) {
+	if strings.TrimSpace(value) == "" || hasCommaValue(*field, value) {
+		return
+	}
+	*field = FormatCommaField(append(ParseCommaField(*field), value))
+}
+
+// removeCommaValue removes all occurrences of value (case-insensitive) from the comma separated field.
+// It returns true if at least one occurrence was removed.
+func removeCommaValue(field *string, value string) (removed bool) {
+	value = strings.TrimSpace(value)
+	values := ParseCommaField(*field)
+	kept := values[:0]
+	for _, existing := range values {
+		if strings.EqualFold(existing, value) {
+			removed = true
+			continue
+		}
+		kept = append(kept, existing)
+	}
+	if removed {
+		*field = FormatCommaField(kept)
+	}
+	return
+}
@@ -0,0 +1,90 @@
+package comicinfo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseCommaField(t *testing.T) {
+	tests := []struct {
+		name     string
+		field    string
+		expected []string
+	}{
+		{"empty", "", nil},
+		{"single", "Stan Lee", []string{"Stan Lee"}},
+		{"canonical", "John Romita, Stan Lee", []string{"John Romita", "Stan Lee"}},
+		{"no space", "John Romita,Stan Lee", []string{"John Romita", "Stan Lee"}},
+		{"trailing comma", "John Romita, Stan Lee,", []string{"John Romita", "Stan Lee"}},
+		{"leading and repeated commas", ",John Romita,, ,Stan Lee", []string{"John Romita", "Stan Lee"}},
+		{"double spaces around", "  John Romita  ,  Stan Lee  ", []string{"John Romita", "Stan Lee"}},
+		{"double spaces inside are kept", "John  Romita, Stan Lee", []string{"John  Romita", "Stan Lee"}},
+		// Unsupported: the spec has no escaping mechanism so a name containing a comma is split
+		{"internal comma", "Romita, John, Lee, Stan", []string{"Romita", "John", "Lee", "Stan"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := ParseCommaField(test.field); !reflect.DeepEqual(got, test.expected) {
+				t.Errorf("expected %q, got %q", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestFormatCommaField(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []string
+		expected string
+	}{
+		{"empty", nil, ""},
+		{"canonical", []string{"John Romita", "Stan Lee"}, "John Romita, Stan Lee"},
+		{"blank values", []string{"", "John Romita", "  ", "Stan Lee"}, "John Romita, Stan Lee"},
+		{"surrounding spaces", []string{"  John Romita", "Stan Lee  "}, "John Romita, Stan Lee"},
+		// Unsupported: the comma of a value is written as is and will be split when parsed back
+		{"internal comma", []string{"Romita, John"}, "Romita, John"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := FormatCommaField(test.values); got != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestNormalizeCommaField(t *testing.T) {
+	tests := map[string]string{
+		" John Romita,Stan Lee ":   "John Romita, Stan Lee",
+		"John Romita, Stan Lee,":   "John Romita, Stan Lee",
+		"John Romita,  ,Stan Lee":  "John Romita, Stan Lee",
+		"John  Romita,   Stan Lee": "John  Romita, Stan Lee",
+		",":                        "",
+	}
+	for field, expected := range tests {
+		if got := NormalizeCommaField(field); got != expected {
+			t.Errorf("NormalizeCommaField(%q): expected %q, got %q", field, expected, got)
+		}
+	}
+}
+
+func TestCommaValueHelpers(t *testing.T) {
+	field := "John Romita, Stan Lee,"
+	if !hasCommaValue(field, " stan lee ") {
+		t.Error("hasCommaValue must ignore case and surrounding spaces")
+	}
+	addCommaValue(&field, "STAN LEE")
+	if field != "John Romita, Stan Lee," {
+		t.Errorf("addCommaValue must not add an existing value, got %q", field)
+	}
+	addCommaValue(&field, "Steve Ditko")
+	if field != "John Romita, Stan Lee, Steve Ditko" {
+		t.Errorf("addCommaValue: unexpected result %q", field)
+	}
+	if !removeCommaValue(&field, "john romita") || field != "Stan Lee, Steve Ditko" {
+		t.Errorf("removeCommaValue: unexpected result %q", field)
+	}
+	if removeCommaValue(&field, "John Romita") {
+		t.Error("removeCommaValue must return false when the value is absent")
+	}
+}
@@ -0,0 +1,236 @@
+package comicinfo
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// CreatorRole identifies one of the comma separated creator fields. Its value is the XML element name of the field.
+type CreatorRole string
+
+const (
+	RoleWriter      CreatorRole = "Writer"
+	RolePenciller   CreatorRole = "Penciller"
+	RoleInker       CreatorRole = "Inker"
+	RoleColorist    CreatorRole = "Colorist"
+	RoleLetterer    CreatorRole = "Letterer"
+	RoleCoverArtist CreatorRole = "CoverArtist"
+	RoleEditor      CreatorRole = "Editor"
+	RoleTranslator  CreatorRole = "Translator" // v2.1 only
+)
+
+// creatorRolesV2 lists the creator roles available in v1 and v2, in the schemas order.
+var creatorRolesV2 = []CreatorRole{RoleWriter, RolePenciller, RoleInker, RoleColorist, RoleLetterer, RoleCoverArtist, RoleEditor}
+
+// creatorRolesV21 lists the creator roles available in v2.1, in the schema order.
+var creatorRolesV21 = []CreatorRole{RoleWriter, RolePenciller, RoleInker, RoleColorist, RoleLetterer, RoleCoverArtist, RoleEditor, RoleTranslator}
+
+// creatorField returns a pointer to the field holding the creators of role, nil if the role does not exist in v1.
+func (ci *ComicInfov1) creatorField(role CreatorRole) *string {
+	switch role {
+	case RoleWriter:
+		return &ci.Writer
+	case RolePenciller:
+		return &ci.Penciller
+	case RoleInker:
+		return &ci.Inker
+	case RoleColorist:
+		return &ci.Colorist
+	case RoleLetterer:
+		return &ci.Letterer
+	case RoleCoverArtist:
+		return &ci.CoverArtist
+	case RoleEditor:
+		return &ci.Editor
+	default:
+		return nil
+	}
+}
+
+// creatorField returns a pointer to the field holding the creators of role, nil if the role does not exist in v2.
+func (ci *ComicInfov2) creatorField(role CreatorRole) *string {
+	switch role {
+	case RoleWriter:
+		return &ci.Writer
+	case RolePenciller:
+		return &ci.Penciller
+	case RoleInker:
+		return &ci.Inker
+	case RoleColorist:
+		return &ci.Colorist
+	case RoleLetterer:
+		return &ci.Letterer
+	case RoleCoverArtist:
+		return &ci.CoverArtist
+	case RoleEditor:
+		return &ci.Editor
+	default:
+		return nil
+	}
+}
+
+// creatorField returns a pointer to the field holding the creators of role, nil if the role is unknown.
+func (ci *ComicInfov21) creatorField(role CreatorRole) *string {
+	switch role {
+	case RoleWriter:
+		return &ci.Writer
+	case RolePenciller:
+		return &ci.Penciller
+	case RoleInker:
+		return &ci.Inker
+	case RoleColorist:
+		return &ci.Colorist
+	case RoleLetterer:
+		return &ci.Letterer
+	case RoleCoverArtist:
+		return &ci.CoverArtist
+	case RoleEditor:
+		return &ci.Editor
+	case RoleTranslator:
+		return &ci.Translator
+	default:
+		return nil
+	}
+}
+
+// GetAllCreators returns the creators of every role which has at least one, see ParseCommaField.
+func (ci ComicInfov2) GetAllCreators() map[CreatorRole][]string {
+	creators := make(map[CreatorRole][]string, len(creatorRolesV2))
+	for _, role := range creatorRolesV2 {
+		if names := ParseCommaField(*ci.creatorField(role)); len(names) > 0 {
+			creators[role] = names
+		}
+	}
+	return creators
+}
+
+// SetAllCreators replaces every creator field with the content of m, roles missing from m being cleared.
+// An error is returned if m contains a role which does not exist in v2 (eg. RoleTranslator), in which case nothing is modified.
+func (ci *ComicInfov2) SetAllCreators(m map[CreatorRole][]string) error {
+	for role := range m {
+		if ci.creatorField(role) == nil {
+			return fmt.Errorf("creator role %q does not exist in ComicInfo v2", role)
+		}
+	}
+	for _, role := range creatorRolesV2 {
+		*ci.creatorField(role) = FormatCommaField(m[role])
+	}
+	return nil
+}
+
+// ValidateCreatorFields checks that no creator field contains an empty entry, eg. "John Romita,,Stan Lee".
+// All the failures are joined within the returned error.
+func (ci ComicInfov1) ValidateCreatorFields() error {
+	errs := make([]error, 0, len(creatorRolesV2))
+	for _, role := range creatorRolesV2 {
+		errs = append(errs, validateCreatorField(role, *ci.creatorField(role)))
+	}
+	return errors.Join(errs...)
+}
+
+// ValidateCreatorFields checks that no creator field contains an empty entry, eg. "John Romita,,Stan Lee".
+// All the failures are joined within the returned error.
+func (ci ComicInfov2) ValidateCreatorFields() error {
+	errs := make([]error, 0, len(creatorRolesV2))
+	for _, role := range creatorRolesV2 {
+		errs = append(errs, validateCreatorField(role, *ci.creatorField(role)))
+	}
+	return errors.Join(errs...)
+}
+
+// ValidateCreatorFields checks that no creator field contains an empty entry, eg. "John Romita,,Stan Lee".
+// All the failures are joined within the returned error.
+func (ci ComicInfov21) ValidateCreatorFields() error {
+	errs := make([]error, 0, len(creatorRolesV21))
+	for _, role := range creatorRolesV21 {
+		errs = append(errs, validateCreatorField(role, *ci.creatorField(role)))
+	}
+	return errors.Join(errs...)
+}
+
+// validateCreatorField returns an error for the first empty entry of a non empty comma separated field. Positions start at 1.
+func validateCreatorField(role CreatorRole, field string) error {
+	if strings.TrimSpace(field) == "" {
+		return nil
+	}
+	for index, value := range strings.Split(field, ",") {
+		if strings.TrimSpace(value) == "" {
+			return fmt.Errorf("%s field contains empty entry at position %d", role, index+1)
+		}
+	}
+	return nil
+}
+
+// GetCreatorByRole returns the creators of role, see ParseCommaField. An error is returned if the role does not exist in v2.
+func (ci ComicInfov2) GetCreatorByRole(role CreatorRole) ([]string, error) {
+	field := ci.creatorField(role)
+	if field == nil {
+		return nil, fmt.Errorf("creator role %q does not exist in ComicInfo v2", role)
+	}
+	return ParseCommaField(*field), nil
+}
+
+// SetCreatorByRole replaces the creators of role with names. An error is returned if the role does not exist in v2.
+func (ci *ComicInfov2) SetCreatorByRole(role CreatorRole, names []string) error {
+	field := ci.creatorField(role)
+	if field == nil {
+		return fmt.Errorf("creator role %q does not exist in ComicInfo v2", role)
+	}
+	*field = FormatCommaField(names)
+	return nil
+}
+
+// GetCreatorByRole returns the creators of role, see ParseCommaField. An error is returned for unknown roles.
+func (ci ComicInfov21) GetCreatorByRole(role CreatorRole) ([]string, error) {
+	field := ci.creatorField(role)
+	if field == nil {
+		return nil, fmt.Errorf("unknown creator role %q", role)
+	}
+	return ParseCommaField(*field), nil
+}
+
+// SetCreatorByRole replaces the creators of role with names. An error is returned for unknown roles.
+func (ci *ComicInfov21) SetCreatorByRole(role CreatorRole, names []string) error {
+	field := ci.creatorField(role)
+	if field == nil {
+		return fmt.Errorf("unknown creator role %q", role)
+	}
+	*field = FormatCommaField(names)
+	return nil
+}
+
+// WriterCount returns the number of writers within the comma separated Writer field.
+func (ci ComicInfov2) WriterCount() int {
+	return len(ParseCommaField(ci.Writer))
+}
+
+// PencillerCount returns the number of pencillers within the comma separated Penciller field.
+func (ci ComicInfov2) PencillerCount() int {
+	return len(ParseCommaField(ci.Penciller))
+}
+
+// InkerCount returns the number of inkers within the comma separated Inker field.
+func (ci ComicInfov2) InkerCount() int {
+	return len(ParseCommaField(ci.Inker))
+}
+
+// ColoristCount returns the number of colorists within the comma separated Colorist field.
+func (ci ComicInfov2) ColoristCount() int {
+	return len(ParseCommaField(ci.Colorist))
+}
+
+// LettererCount returns the number of letterers within the comma separated Letterer field.
+func (ci ComicInfov2) LettererCount() int {
+	return len(ParseCommaField(ci.Letterer))
+}
+
+// CoverArtistCount returns the number of cover artists within the comma separated CoverArtist field.
+func (ci ComicInfov2) CoverArtistCount() int {
+	return len(ParseCommaField(ci.CoverArtist))
+}
+
+// EditorCount returns the number of editors within the comma separated Editor field.
+func (ci ComicInfov2) EditorCount() int {
+	return len(ParseCommaField(ci.Editor))
+}
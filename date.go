@@ -0,0 +1,70 @@
+package comicinfo
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// formatDate returns the ISO 8601 representation of a partial date: "YYYY-MM-DD", "YYYY-MM", "YYYY" or "" if year is not set.
+func formatDate(year, month, day int) string {
+	switch {
+	case year == 0:
+		return ""
+	case month == 0:
+		return fmt.Sprintf("%04d", year)
+	case day == 0:
+		return fmt.Sprintf("%04d-%02d", year, month)
+	default:
+		return fmt.Sprintf("%04d-%02d-%02d", year, month, day)
+	}
+}
+
+// publishDate returns the UTC midnight time of a partial date, missing month and day defaulting to 1. It returns nil if year is not set.
+func publishDate(year, month, day int) *time.Time {
+	if year == 0 {
+		return nil
+	}
+	if month == 0 {
+		month = 1
+	}
+	if day == 0 {
+		day = 1
+	}
+	date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	return &date
+}
+
+// validatePartialDate checks that a partial date is consistent: Month requires Year and Day requires both Year and Month.
+func validatePartialDate(year, month, day int) error {
+	if month != 0 && year == 0 {
+		return errors.New("Month is set but Year is not")
+	}
+	if day != 0 && year == 0 {
+		return errors.New("Day is set but Year is not")
+	}
+	if day != 0 && month == 0 {
+		return errors.New("Day is set but Month is not")
+	}
+	return nil
+}
+
+// validateCalendarDate checks that a partial date (month and day may be 0) is consistent and exists within the calendar.
+func validateCalendarDate(year, month, day int) error {
+	if err := validatePartialDate(year, month, day); err != nil {
+		return err
+	}
+	if year < 0 {
+		return fmt.Errorf("invalid year %d", year)
+	}
+	if month < 0 || month > 12 {
+		return fmt.Errorf("invalid month %d", month)
+	}
+	if day == 0 {
+		return nil
+	}
+	if date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC); date.Day() != day {
+		return fmt.Errorf("%04d-%02d-%02d is not a valid calendar date", year, month, day)
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package comicinfo
+
+import (
+	"testing"
+)
+
+func TestDateString(t *testing.T) {
+	tests := []struct {
+		name             string
+		year, month, day int
+		expected         string
+		expectedV1       string
+	}{
+		{"full date", 2021, 6, 15, "2021-06-15", "2021-06"},
+		{"year and month", 2021, 12, 0, "2021-12", "2021-12"},
+		{"year only", 2021, 0, 0, "2021", "2021"},
+		{"nothing set", 0, 0, 0, "", ""},
+		{"day without month", 2021, 0, 15, "2021", "2021"},
+		{"month without year", 0, 6, 15, "", ""},
+		{"zero padding", 987, 1, 2, "0987-01-02", "0987-01"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			v2 := ComicInfov2{Year: test.year, Month: test.month, Day: test.day}
+			if got := v2.DateString(); got != test.expected {
+				t.Errorf("v2: expected %q, got %q", test.expected, got)
+			}
+			v21 := ComicInfov21{Year: test.year, Month: test.month, Day: test.day}
+			if got := v21.DateString(); got != test.expected {
+				t.Errorf("v2.1: expected %q, got %q", test.expected, got)
+			}
+			v1 := ComicInfov1{Year: test.year, Month: test.month}
+			if got := v1.DateString(); got != test.expectedV1 {
+				t.Errorf("v1: expected %q, got %q", test.expectedV1, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package comicinfo
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// fieldDescriptions holds the documentation of every ComicInfo element, indexed by XML element name.
+// It mirrors the struct fields comments which are not available at runtime.
+var fieldDescriptions = map[string]string{
+	"Title":               "Title of the book.",
+	"Series":              "Title of the series the book is part of.",
+	"Number":              "Number of the book in the series.",
+	"Count":               "The total number of books in the series. The Count could be different on each book in a series. Consuming applications should consider using only the value for the latest book in the series.",
+	"Volume":              "Volume containing the book. Volume is a notion that is specific to US Comics, where the same series can have multiple volumes. Volumes can be referenced by number (1, 2, 3…) or by year (2018, 2020…).",
+	"AlternateSeries":     "Quite specific to US comics, some books can be part of cross-over story arcs. Those fields can be used to specify an alternate series, its number and count of books.",
+	"AlternateNumber":     "Quite specific to US comics, some books can be part of cross-over story arcs. Those fields can be used to specify an alternate series, its number and count of books.",
+	"AlternateCount":      "Quite specific to US comics, some books can be part of cross-over story arcs. Those fields can be used to specify an alternate series, its number and count of books.",
+	"Summary":             "A description or summary of the book.",
+	"Notes":               "A free text field, usually used to store information about the application that created the ComicInfo.xml file.",
+	"Year":                "Usually contains the release date of the book.",
+	"Month":               "Usually contains the release date of the book.",
+	"Day":                 "Usually contains the release date of the book.",
+	"Writer":              "Person or organization responsible for creating the scenario. In order to cater for multiple creator with the same role, it is accepted that values are comma separated.",
+	"Penciller":           "Person or organization responsible for drawing the art. In order to cater for multiple creator with the same role, it is accepted that values are comma separated.",
+	"Inker":               "Person or organization responsible for inking the pencil art. In order to cater for multiple creator with the same role, it is accepted that values are comma separated.",
+	"Colorist":            "Person or organization responsible for applying color to drawings. In order to cater for multiple creator with the same role, it is accepted that values are comma separated.",
+	"Letterer":            "Person or organization responsible for drawing text and speech bubbles. In order to cater for multiple creator with the same role, it is accepted that values are comma separated.",
+	"CoverArtist":         "Person or organization responsible for drawing the cover art. In order to cater for multiple creator with the same role, it is accepted that values are comma separated.",
+	"Editor":              "A person or organization contributing to a resource by revising or elucidating the content, e.g., adding an introduction, notes, or other critical matter. An editor may also prepare a resource for production, publication, or distribution. In order to cater for multiple creator with the same role, it is accepted that values are comma separated.",
+	"Translator":          "A person or organization who renders a text from one language into another, or from an older form of a language into the modern form. This can also be used for fan translations (\"scanlator\"). In order to cater for multiple creator with the same role, it is accepted that values are comma separated.",
+	"Publisher":           "A person or organization responsible for publishing, releasing, or issuing a resource.",
+	"Imprint":             "An imprint is a group of publications under the umbrella of a larger imprint or a Publisher. For example, Vertigo is an Imprint of DC Comics.",
+	"Genre":               "Genre of the book or series. For example, Science-Fiction or Shonen. It is accepted that multiple values are comma separated.",
+	"Tags":                "Tags of the book or series. For example, ninja or school life. It is accepted that multiple values are comma separated.",
+	"Web":                 "A URL pointing to a reference website for the book. It is accepted that multiple values are space separated (as spaces in URL will be encoded as %20).",
+	"PageCount":           "The number of pages in the book. This is an informational field which may disagree with the actual Pages list.",
+	"LanguageISO":         "ISO code of the language the book is written in. You can use \"golang.org/x/text/language\" to get valid codes, eg language.English.String()",
+	"Format":              "The original publication's binding format for scanned physical books or presentation format for digital sources. \"TBP\", \"HC\", \"Web\", \"Digital\" are common designators.",
+	"BlackAndWhite":       "Whether the book is in black and white.",
+	"Manga":               "Whether the book is a manga. This also defines the reading direction as right-to-left when set to YesAndRightToLeft.",
+	"Characters":          "Characters present in the book. It is accepted that multiple values are comma separated.",
+	"Teams":               "Teams present in the book. Usually refer to super-hero teams (e.g. Avengers). It is accepted that multiple values are comma separated.",
+	"Locations":           "Locations mentioned in the book. It is accepted that multiple values are comma separated.",
+	"ScanInformation":     "A free text field, usually used to store information about who scanned the book.",
+	"StoryArc":            "The story arc that books belong to. For example, for Undiscovered Country, issues 1-6 are part of the Destiny story arc, issues 7-12 are part of the Unity story arc.",
+	"StoryArcNumber":      "While StoryArc was originally designed to store the arc within a series, it was often used to indicate that a book was part of a reading order, composed of books from multiple series. Mylar for instance was using the field as such. Since StoryArc itself wasn't able to carry the information about ordering of books within a reading order, StoryArcNumber was added. StoryArc and StoryArcNumber can work in combination, to indicate in which position the book is located at for a specific reading order. It is accepted that multiple values can be specified for both StoryArc and StoryArcNumber. Multiple values are comma separated.",
+	"SeriesGroup":         "A group or collection the series belongs to. It is accepted that multiple values are comma separated.",
+	"AgeRating":           "The age rating of the book. Possible values are \"Unknown\", \"Everyone\", \"Teen\", \"Mature\", \"Adults Only 18+\", \"Not Yet Rated\".",
+	"Pages":               "Pages of the comic book. Each page should have an Image element with a file path to the image.",
+	"CommunityRating":     "Community rating of the book, from 0.0 to 5.0, 2 digits allowed.",
+	"MainCharacterOrTeam": "Main character or team mentioned in the book. It is accepted that a single value should be present.",
+	"Review":              "Review of the book.",
+	"IssueNumberStr":      "Issue number which can not be represented by the Number field, eg. \"1.5\" or \"Annual\". Written within the Number element.",
+	"WordCount":           "Non standard: number of words of the story, for accessibility and reading time estimates.",
+	"Rating":              "Non standard: editorial or critic rating, from 0.0 to 10.0 with 1 digit allowed. Distinct from the crowd sourced CommunityRating.",
+	"GTIN":                "A Global Trade Item Number identifying the book. GTIN incorporates other standards like ISBN, ISSN, EAN, or JAN.",
+}
+
+// pageAttributeDescriptions holds the documentation of the Page element attributes, indexed by XML attribute name.
+var pageAttributeDescriptions = map[string]string{
+	"Image":       "Index of the page within the book, starting at 0.",
+	"Type":        "Type of the page, for example FrontCover or Story.",
+	"DoublePage":  "Whether the image is a double page spread.",
+	"ImageSize":   "Size of the image file in bytes.",
+	"Key":         "File name of the image within the archive.",
+	"Bookmark":    "Bookmark or chapter title starting at this page.",
+	"ImageWidth":  "Width of the image in pixels, -1 if unknown.",
+	"ImageHeight": "Height of the image in pixels, -1 if unknown.",
+}
+
+// comicInfov2FieldNames caches the XML element names of the ComicInfov2 fields, in the struct order.
+var comicInfov2FieldNames = xmlElementNames(reflect.TypeOf(ComicInfov2{}))
+
+// xmlElementNames returns the XML element names of the fields of struct type t, skipping attributes and fields
+// which are not part of the XML output.
+func xmlElementNames(t reflect.Type) (names []string) {
+	for i := range t.NumField() {
+		tag := t.Field(i).Tag.Get("xml")
+		if tag == "-" || strings.Contains(tag, ",attr") {
+			continue
+		}
+		names = append(names, xmlFieldName(t.Field(i)))
+	}
+	return
+}
+
+// FieldNames returns the XML element names of the fields, in the schema order. The non standard fields are not included.
+func (ci ComicInfov2) FieldNames() []string {
+	return slices.Clone(comicInfov2FieldNames)
+}
+
+// FieldDescription returns the documentation of the field whose XML element name is name, or an empty string if unknown.
+func (ci ComicInfov2) FieldDescription(name string) string {
+	if !slices.Contains(comicInfov2FieldNames, name) {
+		return ""
+	}
+	return fieldDescriptions[name]
+}
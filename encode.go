@@ -0,0 +1,20 @@
+package comicinfo
+
+import (
+	"io"
+)
+
+// EncodeV1 is ComicInfov1.Encode as a function, to be passed around as a func(io.Writer, ComicInfov1) error value.
+func EncodeV1(output io.Writer, ci ComicInfov1) error {
+	return ci.Encode(output)
+}
+
+// EncodeV2 is ComicInfov2.Encode as a function, to be passed around as a func(io.Writer, ComicInfov2) error value.
+func EncodeV2(output io.Writer, ci ComicInfov2) error {
+	return ci.Encode(output)
+}
+
+// EncodeV21 is ComicInfov21.Encode as a function, to be passed around as a func(io.Writer, ComicInfov21) error value.
+func EncodeV21(output io.Writer, ci ComicInfov21) error {
+	return ci.Encode(output)
+}
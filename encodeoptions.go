@@ -0,0 +1,33 @@
+package comicinfo
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// EncodeOptions allows to customize the XML output of EncodeWithOptions(). Its zero value produces the same output as Encode().
+type EncodeOptions struct {
+	SchemaURL  string // overrides the default schema location of the version if set
+	OmitSchema bool   // do not add the xmlns:xsi and xsi:schemaLocation attributes to the root element
+	Compact    bool   // no indentation nor newlines, see CompactEncode()
+	// IncludeExtensions writes the non standard fields (eg. WordCount) as additional elements. Consumers following
+	// the schemas strictly may reject the resulting file.
+	IncludeExtensions bool
+}
+
+func (opts EncodeOptions) validate() error {
+	if opts.SchemaURL != "" {
+		if _, err := url.Parse(opts.SchemaURL); err != nil {
+			return fmt.Errorf("invalid schema URL: %w", err)
+		}
+	}
+	return nil
+}
+
+// schemaURL returns the custom schema location if set, defaultURL otherwise.
+func (opts EncodeOptions) schemaURL(defaultURL string) string {
+	if opts.SchemaURL != "" {
+		return opts.SchemaURL
+	}
+	return defaultURL
+}
@@ -0,0 +1,32 @@
+package comicinfo
+
+import (
+	"bytes"
+	"encoding/xml"
+	"reflect"
+	"testing"
+)
+
+func TestCompactEncode(t *testing.T) {
+	ci := newTestComicInfov2()
+	var indented, compact bytes.Buffer
+	if err := ci.Encode(&indented); err != nil {
+		t.Fatalf("Encode failed: %s", err)
+	}
+	if err := ci.CompactEncode(&compact); err != nil {
+		t.Fatalf("CompactEncode failed: %s", err)
+	}
+	if compact.Len() >= indented.Len() {
+		t.Errorf("expected the compact output (%d bytes) to be smaller than the indented one (%d bytes)", compact.Len(), indented.Len())
+	}
+	if bytes.Contains(compact.Bytes(), []byte("\n\t")) {
+		t.Error("compact output must not be indented")
+	}
+	var decoded ComicInfov2
+	if err := xml.Unmarshal(compact.Bytes(), &decoded); err != nil {
+		t.Fatalf("failed to decode compact output: %s", err)
+	}
+	if !reflect.DeepEqual(ci, decoded) {
+		t.Errorf("compact output does not decode to the original:\n%+v\n---\n%+v", ci, decoded)
+	}
+}
@@ -66,13 +66,14 @@ func writeCBZChapter(chapter Chapter, outputDir string) (err error) {
 		return fmt.Errorf("failed to decode cover: %w", err)
 	}
 	ci.Pages.Pages[0] = comicinfo.PageV2{
-		Image:       0,
-		Type:        comicinfo.PageTypeFrontCover,
-		ImageSize:   len(chapter.Serie.Cover.Data),
-		Key:         coverFilename,
-		Bookmark:    "Cover",
-		ImageWidth:  coverImg.Bounds().Dx(),
-		ImageHeight: coverImg.Bounds().Dy(),
+		Type:     comicinfo.PageTypeFrontCover,
+		Key:      coverFilename,
+		Bookmark: "Cover",
+	}
+	if err = ci.Pages.Pages[0].SetDimensions(
+		coverImg.Bounds().Dx(), coverImg.Bounds().Dy(), len(chapter.Serie.Cover.Data),
+	); err != nil {
+		return fmt.Errorf("invalid cover dimensions: %w", err)
 	}
 	// Add images
 	for i, page := range chapter.Pages {
@@ -89,15 +90,15 @@ func writeCBZChapter(chapter Chapter, outputDir string) (err error) {
 			return fmt.Errorf("can not decode image at page #%d: %w", i, err)
 		}
 		ci.Pages.Pages[i+1] = comicinfo.PageV2{
-			Image:       i + 1,
-			Type:        comicinfo.PageTypeStory,
-			ImageSize:   len(page.Data),
-			Key:         pageName,
-			Bookmark:    fmt.Sprintf("Page %d", i+1),
-			ImageWidth:  img.Bounds().Dx(),
-			ImageHeight: img.Bounds().Dy(),
+			Type:     comicinfo.PageTypeStory,
+			Key:      pageName,
+			Bookmark: fmt.Sprintf("Page %d", i+1),
+		}
+		if err = ci.Pages.Pages[i+1].SetDimensions(img.Bounds().Dx(), img.Bounds().Dy(), len(page.Data)); err != nil {
+			return fmt.Errorf("invalid dimensions for page #%d: %w", i, err)
 		}
 	}
+	ci.Pages.AutoAssignIndices()
 	// Write ComicInfo.xml within the zip
 	ciWriter, err := cbzWriter.Create(comicinfo.ComicInfoFileName)
 	if err != nil {
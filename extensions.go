@@ -0,0 +1,65 @@
+package comicinfo
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// comicInfov2Extensions holds the XML representation of the non standard ComicInfov2 fields.
+// They are not part of any ComicInfo schema and are only written when EncodeOptions.IncludeExtensions is set.
+type comicInfov2Extensions struct {
+	WordCount int    `xml:"WordCount,omitempty"`
+	Rating    string `xml:"Rating,omitempty"`
+}
+
+func (ci ComicInfov2) extensions() (ext comicInfov2Extensions) {
+	ext.WordCount = ci.WordCount
+	if ci.Rating != nil {
+		ext.Rating = strconv.FormatFloat(*ci.Rating, 'f', 1, 64)
+	}
+	return
+}
+
+func (ci *ComicInfov2) setExtensions(ext comicInfov2Extensions) error {
+	ci.WordCount = ext.WordCount
+	if ext.Rating != "" {
+		rating, err := strconv.ParseFloat(ext.Rating, 64)
+		if err != nil {
+			return fmt.Errorf("failed to parse Rating: %w", err)
+		}
+		ci.Rating = &rating
+	}
+	return nil
+}
+
+// ValidateExtensions checks the non standard fields, which are not covered by Validate(). All the failures are joined within the returned error.
+func (ci ComicInfov2) ValidateExtensions() error {
+	var errs []error
+	if ci.WordCount < 0 {
+		errs = append(errs, fmt.Errorf("WordCount must be >= 0, got %d", ci.WordCount))
+	}
+	return errors.Join(errs...)
+}
+
+// NewRating returns a rating suitable for the non standard Rating field: f rounded to 1 digit.
+// An error is returned if f is not within 0.0-10.0.
+func NewRating(f float64) (*float64, error) {
+	rating := math.Round(f*10) / 10
+	if err := validateRating(rating); err != nil {
+		return nil, err
+	}
+	return &rating, nil
+}
+
+func validateRating(rating float64) error {
+	if math.IsNaN(rating) || rating < 0 || rating > 10 {
+		return fmt.Errorf("rating %v is not within 0.0-10.0", rating)
+	}
+	// 1 digit allowed
+	if math.Abs(rating-math.Round(rating*10)/10) > 1e-9 {
+		return fmt.Errorf("rating %v has more than 1 digit", rating)
+	}
+	return nil
+}
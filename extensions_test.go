@@ -0,0 +1,45 @@
+package comicinfo
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+func TestExtensionsRoundTrip(t *testing.T) {
+	ci := newTestComicInfov2()
+	ci.WordCount = 4200
+	ci.Rating, _ = NewRating(7.5)
+	var encoded bytes.Buffer
+	if err := ci.EncodeWithOptions(&encoded, EncodeOptions{IncludeExtensions: true}); err != nil {
+		t.Fatalf("EncodeWithOptions failed: %s", err)
+	}
+	for _, element := range []string{"<WordCount>4200</WordCount>", "<Rating>7.5</Rating>"} {
+		if !strings.Contains(encoded.String(), element) {
+			t.Errorf("expected %s within the output", element)
+		}
+	}
+	var decoded ComicInfov2
+	if err := xml.Unmarshal(encoded.Bytes(), &decoded); err != nil {
+		t.Fatalf("failed to decode: %s", err)
+	}
+	if decoded.WordCount != ci.WordCount {
+		t.Errorf("WordCount: expected %d, got %d", ci.WordCount, decoded.WordCount)
+	}
+	if decoded.Rating == nil || *decoded.Rating != *ci.Rating {
+		t.Errorf("Rating: expected %v, got %v", *ci.Rating, decoded.Rating)
+	}
+}
+
+func TestExtensionsOmittedByDefault(t *testing.T) {
+	ci := newTestComicInfov2()
+	ci.WordCount = 4200
+	var encoded bytes.Buffer
+	if err := ci.Encode(&encoded); err != nil {
+		t.Fatalf("Encode failed: %s", err)
+	}
+	if strings.Contains(encoded.String(), "WordCount") {
+		t.Error("extensions must not be written without EncodeOptions.IncludeExtensions")
+	}
+}
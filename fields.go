@@ -0,0 +1,126 @@
+package comicinfo
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// xmlFieldName returns the XML element name of a struct field, falling back to the field name if the tag does not define one
+// or if the field is not part of the XML output.
+func xmlFieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("xml"), ",")
+	if name == "" || name == "-" {
+		return field.Name
+	}
+	return name
+}
+
+// fieldByXMLName returns the field of the struct value v whose XML element name is name.
+func fieldByXMLName(v reflect.Value, name string) (reflect.Value, bool) {
+	t := v.Type()
+	for i := range t.NumField() {
+		if xmlFieldName(t.Field(i)) == name {
+			return v.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// setFieldValue assigns value to field, converting it if needed. A nil value resets the field to its zero value.
+// Pointer fields accept either a pointer or a value of the pointed type.
+func setFieldValue(field reflect.Value, value interface{}) error {
+	if value == nil {
+		field.Set(reflect.Zero(field.Type()))
+		return nil
+	}
+	rv := reflect.ValueOf(value)
+	target := field.Type()
+	if target.Kind() == reflect.Pointer && rv.Kind() != reflect.Pointer {
+		target = target.Elem()
+	}
+	if !rv.Type().ConvertibleTo(target) || rv.Kind() != target.Kind() {
+		return fmt.Errorf("can not use a %s value as %s", rv.Type(), field.Type())
+	}
+	rv = rv.Convert(target)
+	if target != field.Type() {
+		ptr := reflect.New(target)
+		ptr.Elem().Set(rv)
+		rv = ptr
+	}
+	field.Set(rv)
+	return nil
+}
+
+// coerceFieldValue converts value to the kind of the target type when setFieldValue would not: numeric strings
+// for numeric fields (eg. "5" for Number), integers for string or float fields and any integer kind for int fields.
+// Other values are returned as is.
+func coerceFieldValue(target reflect.Type, value interface{}) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+	if target.Kind() == reflect.Pointer {
+		target = target.Elem()
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.String:
+		switch target.Kind() {
+		case reflect.Int:
+			number, err := strconv.Atoi(strings.TrimSpace(rv.String()))
+			if err != nil {
+				return nil, fmt.Errorf("invalid integer %q: %w", rv.String(), err)
+			}
+			return number, nil
+		case reflect.Float64:
+			number, err := strconv.ParseFloat(strings.TrimSpace(rv.String()), 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid number %q: %w", rv.String(), err)
+			}
+			return number, nil
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		switch target.Kind() {
+		case reflect.Int:
+			return int(rv.Int()), nil
+		case reflect.Float64:
+			return float64(rv.Int()), nil
+		case reflect.String:
+			return strconv.FormatInt(rv.Int(), 10), nil
+		}
+	}
+	return value, nil
+}
+
+// Get returns the value of a field identified by its XML element name, eg. "Title", "AgeRating" or "Number".
+// Non standard fields are identified by their Go name, eg. "WordCount".
+func (ci ComicInfov2) Get(field string) (interface{}, error) {
+	value, found := fieldByXMLName(reflect.ValueOf(ci), field)
+	if !found {
+		return nil, fmt.Errorf("unknown field %q", field)
+	}
+	return value.Interface(), nil
+}
+
+// Set assigns value to a field identified by its XML element name (see Get). Values are converted when possible,
+// eg. "5" can be used for Number and 3.5 for CommunityRating. A nil value resets the field.
+func (ci *ComicInfov2) Set(field string, value interface{}) error {
+	return setFieldByXMLName(reflect.ValueOf(ci).Elem(), field, value)
+}
+
+// setFieldByXMLName assigns value, converted by coerceFieldValue, to the field of the struct value v whose XML element name is name.
+func setFieldByXMLName(v reflect.Value, name string, value interface{}) error {
+	target, found := fieldByXMLName(v, name)
+	if !found {
+		return fmt.Errorf("unknown field %q", name)
+	}
+	value, err := coerceFieldValue(target.Type(), value)
+	if err != nil {
+		return fmt.Errorf("failed to set %s: %w", name, err)
+	}
+	if err = setFieldValue(target, value); err != nil {
+		return fmt.Errorf("failed to set %s: %w", name, err)
+	}
+	return nil
+}
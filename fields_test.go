@@ -0,0 +1,109 @@
+package comicinfo
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestGetSetAllFields copies every field of a fully populated ComicInfov2 into an empty one through Get and Set.
+func TestGetSetAllFields(t *testing.T) {
+	source := newTestComicInfov2()
+	source.IssueNumberStr = "12.5"
+	source.WordCount = 4200
+	source.Rating, _ = NewRating(7.5)
+	var target ComicInfov2
+	st := reflect.TypeOf(source)
+	for i := range st.NumField() {
+		name := xmlFieldName(st.Field(i))
+		value, err := source.Get(name)
+		if err != nil {
+			t.Errorf("Get(%q) failed: %s", name, err)
+			continue
+		}
+		if !reflect.DeepEqual(value, reflect.ValueOf(source).Field(i).Interface()) {
+			t.Errorf("Get(%q): expected %v, got %v", name, reflect.ValueOf(source).Field(i), value)
+		}
+		if err = target.Set(name, value); err != nil {
+			t.Errorf("Set(%q) failed: %s", name, err)
+		}
+	}
+	if !reflect.DeepEqual(source, target) {
+		t.Errorf("fields copied through Get/Set differ:\n%+v\n---\n%+v", source, target)
+	}
+	// Setting nil resets every field
+	for i := range st.NumField() {
+		name := xmlFieldName(st.Field(i))
+		if err := target.Set(name, nil); err != nil {
+			t.Errorf("Set(%q, nil) failed: %s", name, err)
+		}
+	}
+	if !reflect.DeepEqual(target, ComicInfov2{}) {
+		t.Errorf("expected every field to be reset, got %+v", target)
+	}
+}
+
+func TestSetConversions(t *testing.T) {
+	tests := []struct {
+		field    string
+		value    interface{}
+		expected interface{}
+	}{
+		{"Title", "The Last Stand", "The Last Stand"},
+		{"Number", 5, 5},
+		{"Number", "5", 5},
+		{"Number", " 7 ", 7},
+		{"Number", int64(9), 9},
+		{"Series", 42, "42"},
+		{"AgeRating", "Teen", AgeRatingTeen},
+		{"Manga", MangaYes, MangaYes},
+		{"BlackAndWhite", "Yes", Yes},
+		{"IssueNumberStr", "Annual", IssueNumber("Annual")},
+		{"CommunityRating", 3.5, CommunityRating(3.5)},
+		{"CommunityRating", "4", CommunityRating(4)},
+		{"CommunityRating", 2, CommunityRating(2)},
+		{"Rating", 7.5, 7.5},
+	}
+	for _, test := range tests {
+		var ci ComicInfov2
+		if err := ci.Set(test.field, test.value); err != nil {
+			t.Errorf("Set(%q, %v) failed: %s", test.field, test.value, err)
+			continue
+		}
+		got, err := ci.Get(test.field)
+		if err != nil {
+			t.Errorf("Get(%q) failed: %s", test.field, err)
+			continue
+		}
+		if rv := reflect.ValueOf(got); rv.Kind() == reflect.Pointer {
+			got = rv.Elem().Interface()
+		}
+		if !reflect.DeepEqual(got, test.expected) {
+			t.Errorf("Set(%q, %v): expected %v (%T), got %v (%T)", test.field, test.value, test.expected, test.expected, got, got)
+		}
+	}
+}
+
+func TestGetSetErrors(t *testing.T) {
+	var ci ComicInfov2
+	if _, err := ci.Get("Unknown"); err == nil {
+		t.Error("Get must fail on an unknown field")
+	}
+	if err := ci.Set("Unknown", "value"); err == nil {
+		t.Error("Set must fail on an unknown field")
+	}
+	if err := ci.Set("Number", "five"); err == nil {
+		t.Error("Set must fail on a non numeric string for an int field")
+	}
+	if err := ci.Set("Year", uint8(21)); err == nil {
+		t.Error("Set must fail on an unsigned integer for an int field")
+	}
+	if err := ci.Set("Pages", "page1.jpg"); err == nil {
+		t.Error("Set must fail on a string for the Pages field")
+	}
+	if err := ci.Set("Title", []string{"The Last Stand"}); err == nil {
+		t.Error("Set must fail on a slice for a string field")
+	}
+	if !reflect.DeepEqual(ci, ComicInfov2{}) {
+		t.Errorf("failed Set calls must not modify the struct, got %+v", ci)
+	}
+}
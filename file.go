@@ -0,0 +1,90 @@
+package comicinfo
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// EncodeV1ToFile encodes ci to path (see ComicInfov1.Encode), creating or replacing the file.
+// An existing file is left untouched if an error occurs, eg. if ci fails validation.
+func EncodeV1ToFile(path string, ci ComicInfov1) error {
+	return encodeToFile(path, ci.Encode)
+}
+
+// DecodeV1FromFile reads and validates the v1 ComicInfo stored at path.
+func DecodeV1FromFile(path string) (ci ComicInfov1, err error) {
+	if err = decodeFromFile(path, &ci); err != nil {
+		return
+	}
+	if err = ci.Validate(); err != nil {
+		return ci, fmt.Errorf("validation failed: %w", err)
+	}
+	return
+}
+
+// EncodeV21ToFile encodes ci to path (see ComicInfov21.Encode), creating or replacing the file.
+// An existing file is left untouched if an error occurs, eg. if ci fails validation.
+func EncodeV21ToFile(path string, ci ComicInfov21) error {
+	return encodeToFile(path, ci.Encode)
+}
+
+// DecodeV21FromFile reads and validates the v2.1 ComicInfo stored at path.
+func DecodeV21FromFile(path string) (ci ComicInfov21, err error) {
+	if err = decodeFromFile(path, &ci); err != nil {
+		return
+	}
+	if err = ci.Validate(); err != nil {
+		return ci, fmt.Errorf("validation failed: %w", err)
+	}
+	return
+}
+
+// encodeToFile encodes to a temporary file within the same directory which is then renamed over path,
+// so an existing file is left untouched if encoding (and thus validation) fails.
+func encodeToFile(path string, encode func(io.Writer) error) (err error) {
+	perm := os.FileMode(0o644)
+	if info, err := os.Stat(path); err == nil {
+		perm = info.Mode().Perm()
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			tmp.Close()
+			os.Remove(tmp.Name())
+		}
+	}()
+	if err = encode(tmp); err != nil {
+		return
+	}
+	if err = tmp.Sync(); err != nil {
+		return fmt.Errorf("failed to sync temporary file: %w", err)
+	}
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close temporary file: %w", err)
+	}
+	if err = os.Chmod(tmp.Name(), perm); err != nil {
+		return fmt.Errorf("failed to set temporary file permissions: %w", err)
+	}
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to replace file: %w", err)
+	}
+	return
+}
+
+func decodeFromFile(path string, v any) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open file: %w", err)
+	}
+	defer file.Close()
+	if err = xml.NewDecoder(file).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode XML: %w", err)
+	}
+	return nil
+}
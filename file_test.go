@@ -0,0 +1,69 @@
+package comicinfo
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestEncodeToFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, ComicInfoFileName)
+	ci := newTestComicInfov21()
+	if err := EncodeV21ToFile(path, ci); err != nil {
+		t.Fatalf("EncodeV21ToFile failed: %s", err)
+	}
+	decoded, err := DecodeV21FromFile(path)
+	if err != nil {
+		t.Fatalf("DecodeV21FromFile failed: %s", err)
+	}
+	if !reflect.DeepEqual(ci, decoded) {
+		t.Errorf("round trip mismatch:\n%+v\n---\n%+v", ci, decoded)
+	}
+	if err = os.Chmod(path, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	ci.Title = "Replaced"
+	if err = EncodeV21ToFile(path, ci); err != nil {
+		t.Fatalf("EncodeV21ToFile failed to replace the file: %s", err)
+	}
+	if decoded, err = DecodeV21FromFile(path); err != nil || decoded.Title != "Replaced" {
+		t.Errorf("expected the replaced file to be decoded, got %q (%v)", decoded.Title, err)
+	}
+	if info, err := os.Stat(path); err != nil || info.Mode().Perm() != 0o600 {
+		t.Errorf("expected the file permissions to be kept, got %v (%v)", info.Mode().Perm(), err)
+	}
+}
+
+func TestEncodeToFileInvalidKeepsExistingFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, ComicInfoFileName)
+	if err := EncodeV1ToFile(path, newTestComicInfov1()); err != nil {
+		t.Fatalf("EncodeV1ToFile failed: %s", err)
+	}
+	original, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = EncodeV1ToFile(path, ComicInfov1{Month: 13}); err == nil {
+		t.Fatal("expected a validation error")
+	}
+	if err = EncodeV21ToFile(path, ComicInfov21{Month: 13}); err == nil {
+		t.Fatal("expected a validation error")
+	}
+	current, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(current) != string(original) {
+		t.Errorf("an invalid ComicInfo must not modify the existing file, got:\n%s", current)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("expected the temporary file to be removed, got %d entries", len(entries))
+	}
+}
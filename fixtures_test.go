@@ -0,0 +1,155 @@
+package comicinfo
+
+import (
+	"fmt"
+)
+
+// newTestPagesV2 returns count valid pages, the first one being the front cover.
+func newTestPagesV2(count int) PagesV2 {
+	pages := make([]PageV2, count)
+	for i := range pages {
+		pageType := PageTypeStory
+		switch i {
+		case 0:
+			pageType = PageTypeFrontCover
+		case count - 1:
+			pageType = PageTypeBackCover
+		}
+		pages[i] = PageV2{
+			Image:       i,
+			Type:        pageType,
+			ImageSize:   512000 + i,
+			Key:         fmt.Sprintf("page%03d.jpg", i),
+			ImageWidth:  1988,
+			ImageHeight: 3056,
+		}
+	}
+	return PagesV2{Pages: pages}
+}
+
+// newTestComicInfov2 returns a realistic, valid v2 document: every creator field is set,
+// the community rating is present and the issue has 24 pages.
+func newTestComicInfov2() ComicInfov2 {
+	rating := CommunityRating(4.5)
+	return ComicInfov2{
+		Title:               "The Last Stand",
+		Series:              "Example Saga",
+		Number:              12,
+		Count:               24,
+		Volume:              2,
+		AlternateSeries:     "Example Crossover",
+		AlternateNumber:     3,
+		AlternateCount:      6,
+		Summary:             "Our heroes face their greatest challenge yet.",
+		Notes:               "Tagged by example 1.0",
+		Year:                2021,
+		Month:               6,
+		Day:                 15,
+		Writer:              "Jane Doe, John Smith",
+		Penciller:           "Alice Martin",
+		Inker:               "Bob Lee",
+		Colorist:            "Carol White",
+		Letterer:            "Dave Brown",
+		CoverArtist:         "Eve Black",
+		Editor:              "Frank Green",
+		Publisher:           "Example Comics",
+		Imprint:             "Example Select",
+		Genre:               "Action, Adventure",
+		Web:                 "https://example.com/saga/12",
+		PageCount:           24,
+		LanguageISO:         "en",
+		Format:              "Series",
+		BlackAndWhite:       No,
+		Manga:               MangaNo,
+		Characters:          "Hero, Sidekick, Villain",
+		Teams:               "The Defenders",
+		Locations:           "Metro City",
+		ScanInformation:     "Digital",
+		StoryArc:            "Final Hour",
+		SeriesGroup:         "Example Universe",
+		AgeRating:           AgeRatingTeen,
+		Pages:               newTestPagesV2(24),
+		CommunityRating:     &rating,
+		MainCharacterOrTeam: "Hero",
+		Review:              "A satisfying conclusion.",
+	}
+}
+
+// newTestComicInfov1 returns the v1 counterpart of newTestComicInfov2.
+func newTestComicInfov1() ComicInfov1 {
+	return ComicInfov1{
+		Title:         "The Last Stand",
+		Series:        "Example Saga",
+		Number:        12,
+		Count:         24,
+		Volume:        2,
+		Summary:       "Our heroes face their greatest challenge yet.",
+		Year:          2021,
+		Month:         6,
+		Writer:        "Jane Doe, John Smith",
+		Penciller:     "Alice Martin",
+		Inker:         "Bob Lee",
+		Colorist:      "Carol White",
+		Letterer:      "Dave Brown",
+		CoverArtist:   "Eve Black",
+		Editor:        "Frank Green",
+		Publisher:     "Example Comics",
+		Genre:         "Action, Adventure",
+		PageCount:     24,
+		Language:      "en",
+		BlackAndWhite: No,
+		Manga:         MangaNo,
+	}
+}
+
+// newTestComicInfov21 returns the v2.1 counterpart of newTestComicInfov2, with the v2.1 only fields set.
+func newTestComicInfov21() ComicInfov21 {
+	v2 := newTestComicInfov2()
+	rating := CommunityRatingV21(*v2.CommunityRating)
+	return ComicInfov21{
+		Title:               v2.Title,
+		Series:              v2.Series,
+		Number:              v2.Number,
+		Count:               v2.Count,
+		Volume:              v2.Volume,
+		AlternateSeries:     v2.AlternateSeries,
+		AlternateNumber:     v2.AlternateNumber,
+		AlternateCount:      v2.AlternateCount,
+		Summary:             v2.Summary,
+		Notes:               v2.Notes,
+		Year:                v2.Year,
+		Month:               v2.Month,
+		Day:                 v2.Day,
+		Writer:              v2.Writer,
+		Penciller:           v2.Penciller,
+		Inker:               v2.Inker,
+		Colorist:            v2.Colorist,
+		Letterer:            v2.Letterer,
+		CoverArtist:         v2.CoverArtist,
+		Editor:              v2.Editor,
+		Translator:          "Grace Hopper",
+		Publisher:           v2.Publisher,
+		Imprint:             v2.Imprint,
+		Genre:               v2.Genre,
+		Tags:                "finale, crossover",
+		Web:                 v2.Web,
+		PageCount:           v2.PageCount,
+		LanguageISO:         v2.LanguageISO,
+		Format:              v2.Format,
+		BlackAndWhite:       v2.BlackAndWhite,
+		Manga:               v2.Manga,
+		Characters:          v2.Characters,
+		Teams:               v2.Teams,
+		Locations:           v2.Locations,
+		ScanInformation:     v2.ScanInformation,
+		StoryArc:            v2.StoryArc,
+		StoryArcNumber:      "4",
+		SeriesGroup:         v2.SeriesGroup,
+		AgeRating:           v2.AgeRating,
+		Pages:               v2.Pages,
+		CommunityRating:     &rating,
+		MainCharacterOrTeam: v2.MainCharacterOrTeam,
+		Review:              v2.Review,
+		GTIN:                "9780306406157",
+	}
+}
@@ -0,0 +1,95 @@
+package comicinfo
+
+import (
+	"bytes"
+	"encoding/xml"
+	"reflect"
+	"testing"
+)
+
+// The fuzz targets check that decoding arbitrary input never panics and that a document which decodes without error
+// and passes validation is not partially corrupted: it must encode and decode back to the same value.
+
+func FuzzDecodeV1(f *testing.F) {
+	var seed bytes.Buffer
+	if err := newTestComicInfov1().Encode(&seed); err != nil {
+		f.Fatalf("failed to encode seed: %s", err)
+	}
+	f.Add(seed.Bytes())
+	f.Add([]byte("<ComicInfo></ComicInfo>"))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var ci ComicInfov1
+		if xml.Unmarshal(data, &ci) != nil || ci.Validate() != nil {
+			return
+		}
+		var encoded bytes.Buffer
+		if err := ci.Encode(&encoded); err != nil {
+			t.Fatalf("valid document failed to encode: %s", err)
+		}
+		var decoded ComicInfov1
+		if err := xml.Unmarshal(encoded.Bytes(), &decoded); err != nil {
+			t.Fatalf("encoded document failed to decode: %s\n%s", err, encoded.String())
+		}
+		if !reflect.DeepEqual(ci, decoded) {
+			t.Fatalf("round trip mismatch:\n%+v\n---\n%+v", ci, decoded)
+		}
+	})
+}
+
+func FuzzDecodeV2(f *testing.F) {
+	var seed bytes.Buffer
+	if err := newTestComicInfov2().Encode(&seed); err != nil {
+		f.Fatalf("failed to encode seed: %s", err)
+	}
+	f.Add(seed.Bytes())
+	f.Add([]byte("<ComicInfo></ComicInfo>"))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var ci ComicInfov2
+		if xml.Unmarshal(data, &ci) != nil || ci.Validate() != nil {
+			return
+		}
+		var encoded bytes.Buffer
+		if err := ci.EncodeWithOptions(&encoded, EncodeOptions{IncludeExtensions: true}); err != nil {
+			t.Fatalf("valid document failed to encode: %s", err)
+		}
+		var decoded ComicInfov2
+		if err := xml.Unmarshal(encoded.Bytes(), &decoded); err != nil {
+			t.Fatalf("encoded document failed to decode: %s\n%s", err, encoded.String())
+		}
+		if ci.PageCount == 0 && len(ci.Pages.Pages) > 0 {
+			ci.SyncPageCount() // filled in by Encode
+		}
+		if !reflect.DeepEqual(ci, decoded) {
+			t.Fatalf("round trip mismatch:\n%+v\n---\n%+v", ci, decoded)
+		}
+	})
+}
+
+func FuzzDecodeV21(f *testing.F) {
+	var seed bytes.Buffer
+	if err := newTestComicInfov21().Encode(&seed); err != nil {
+		f.Fatalf("failed to encode seed: %s", err)
+	}
+	f.Add(seed.Bytes())
+	f.Add([]byte("<ComicInfo></ComicInfo>"))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var ci ComicInfov21
+		if xml.Unmarshal(data, &ci) != nil || ci.Validate() != nil {
+			return
+		}
+		var encoded bytes.Buffer
+		if err := ci.Encode(&encoded); err != nil {
+			t.Fatalf("valid document failed to encode: %s", err)
+		}
+		var decoded ComicInfov21
+		if err := xml.Unmarshal(encoded.Bytes(), &decoded); err != nil {
+			t.Fatalf("encoded document failed to decode: %s\n%s", err, encoded.String())
+		}
+		if ci.PageCount == 0 && len(ci.Pages.Pages) > 0 {
+			ci.SyncPageCount() // filled in by Encode
+		}
+		if !reflect.DeepEqual(ci, decoded) {
+			t.Fatalf("round trip mismatch:\n%+v\n---\n%+v", ci, decoded)
+		}
+	})
+}
@@ -1,15 +1,103 @@
 package comicinfo
 
 import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
 	"golang.org/x/text/language"
 )
 
 const (
 	ComicInfoFileName = "ComicInfo.xml"
 	xmlnsxni          = "http://www.w3.org/2001/XMLSchema-instance"
+
+	sniffLen = 512
 )
 
 var (
 	// LanguageEnglish is the standard English language ISO code. Available as a helper/shortcut.
 	LanguageEnglish = language.English.String()
 )
+
+// IsComicInfoXML cheaply checks if the stream looks like a ComicInfo XML file by searching for the <ComicInfo root element
+// within its first bytes. It does not parse the XML. Pass a *bufio.Reader to keep the stream intact for further reading:
+// it will only be peeked. Any other reader will be partially consumed.
+func IsComicInfoXML(r io.Reader) bool {
+	if r == nil {
+		return false
+	}
+	br, ok := r.(*bufio.Reader)
+	if !ok {
+		br = bufio.NewReaderSize(r, sniffLen)
+	}
+	head, _ := br.Peek(sniffLen) // an error means less data than requested, check what we got anyway
+	for {
+		index := bytes.Index(head, []byte("<ComicInfo"))
+		if index == -1 {
+			return false
+		}
+		head = head[index+len("<ComicInfo"):]
+		if len(head) == 0 {
+			return false
+		}
+		switch head[0] {
+		case ' ', '\t', '\r', '\n', '>', '/':
+			return true
+		}
+	}
+}
+
+// ValidateLanguageCode checks that code is a well-formed BCP 47 language tag, eg. "en" or "pt-BR".
+func ValidateLanguageCode(code string) error {
+	if _, err := language.Parse(code); err != nil {
+		return fmt.Errorf("invalid language code %q: %w", code, err)
+	}
+	return nil
+}
+
+// NormalizeLanguageCode returns the canonical BCP 47 form of a language code, eg. "en-us" becomes "en-US" and "ZH" becomes "zh".
+func NormalizeLanguageCode(code string) (string, error) {
+	tag, err := language.Parse(code)
+	if err != nil {
+		return "", fmt.Errorf("invalid language code %q: %w", code, err)
+	}
+	return tag.String(), nil
+}
+
+// validateNumbering checks that none of the numbering fields is negative, issue #0 being legitimate.
+// Count, Volume and AlternateCount also accept -1, their schema default meaning unset.
+func validateNumbering(number, count, volume, alternateNumber, alternateCount int) (errs []error) {
+	for index, value := range [...]int{number, count, volume, alternateNumber, alternateCount} {
+		if minimum := numberingFieldMinimums[index]; value < minimum {
+			errs = append(errs, fmt.Errorf("%s must be >= %d, got %d", numberingFieldNames[index], minimum, value))
+		}
+	}
+	return
+}
+
+// unsetNumbering is the schemas default value of the Count, Volume and AlternateCount elements.
+const unsetNumbering = -1
+
+var (
+	numberingFieldNames    = [...]string{"Number", "Count", "Volume", "AlternateNumber", "AlternateCount"}
+	numberingFieldMinimums = [...]int{0, unsetNumbering, unsetNumbering, 0, unsetNumbering}
+)
+
+// validateAlternateSeries checks that the alternate numbering is not set without the alternate series it refers to.
+func validateAlternateSeries(series string, number, count int) error {
+	if strings.TrimSpace(series) != "" {
+		return nil
+	}
+	switch {
+	case number != 0:
+		return errors.New("AlternateNumber is set but AlternateSeries is empty")
+	case count != 0 && count != unsetNumbering:
+		return errors.New("AlternateCount is set but AlternateSeries is empty")
+	default:
+		return nil
+	}
+}
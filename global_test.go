@@ -0,0 +1,88 @@
+package comicinfo
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+func TestNormalizeLanguageCode(t *testing.T) {
+	tests := []struct {
+		code     string
+		expected string
+		invalid  bool
+	}{
+		{code: "en", expected: "en"},
+		{code: "EN", expected: "en"},
+		{code: "ZH", expected: "zh"},
+		{code: "en-us", expected: "en-US"},
+		{code: "EN-US", expected: "en-US"},
+		{code: "pt-br", expected: "pt-BR"},
+		{code: "pt_BR", expected: "pt-BR"},
+		{code: "zh-hant-tw", expected: "zh-Hant-TW"},
+		{code: "fr-FR", expected: "fr-FR"},
+		{code: "", invalid: true},
+		{code: "english", invalid: true},
+		{code: "en-", invalid: true},
+		{code: "123", invalid: true},
+		{code: "not a language", invalid: true},
+	}
+	for _, test := range tests {
+		got, err := NormalizeLanguageCode(test.code)
+		if test.invalid {
+			if err == nil {
+				t.Errorf("NormalizeLanguageCode(%q): expected an error, got %q", test.code, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("NormalizeLanguageCode(%q): unexpected error: %s", test.code, err)
+		} else if got != test.expected {
+			t.Errorf("NormalizeLanguageCode(%q): expected %q, got %q", test.code, test.expected, got)
+		}
+	}
+}
+
+func TestValidateNumbering(t *testing.T) {
+	tests := []struct {
+		name                                                   string
+		number, count, volume, alternateNumber, alternateCount int
+		errors                                                 int
+	}{
+		{"unset", 0, 0, 0, 0, 0, 0},
+		{"set", 12, 24, 2, 3, 6, 0},
+		{"schema defaults", 0, -1, -1, 0, -1, 0},
+		{"below schema defaults", 0, -2, -2, 0, -2, 3},
+		{"negative numbers", -1, 0, 0, -1, 0, 2},
+	}
+	for _, test := range tests {
+		errs := validateNumbering(test.number, test.count, test.volume, test.alternateNumber, test.alternateCount)
+		if len(errs) != test.errors {
+			t.Errorf("%s: expected %d errors, got %v", test.name, test.errors, errs)
+		}
+	}
+}
+
+func TestValidateSchemaDefaultNumbering(t *testing.T) {
+	document := []byte("<ComicInfo><Title>The Last Stand</Title><Count>-1</Count><Volume>-1</Volume><AlternateCount>-1</AlternateCount></ComicInfo>")
+	var v1 ComicInfov1
+	if err := xml.Unmarshal(document, &v1); err != nil {
+		t.Fatalf("failed to decode v1: %s", err)
+	}
+	if err := v1.Validate(); err != nil {
+		t.Errorf("v1: unexpected validation error: %s", err)
+	}
+	var v2 ComicInfov2
+	if err := xml.Unmarshal(document, &v2); err != nil {
+		t.Fatalf("failed to decode v2: %s", err)
+	}
+	if err := v2.Validate(); err != nil {
+		t.Errorf("v2: unexpected validation error: %s", err)
+	}
+	var v21 ComicInfov21
+	if err := xml.Unmarshal(document, &v21); err != nil {
+		t.Fatalf("failed to decode v2.1: %s", err)
+	}
+	if err := v21.Validate(); err != nil {
+		t.Errorf("v2.1: unexpected validation error: %s", err)
+	}
+}
@@ -0,0 +1,188 @@
+package comicinfo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseISBN parses an ISBN-10 or ISBN-13, with or without hyphens or spaces, and returns it as a 13 digits GTIN
+// suitable for the v2.1 GTIN field. The check digit is verified for both forms.
+func ParseISBN(s string) (string, error) {
+	isbn := stripISBNSeparators(s)
+	switch len(isbn) {
+	case 10:
+		if !ValidateISBN10(isbn) {
+			return "", fmt.Errorf("invalid ISBN-10: %q", s)
+		}
+		gtin := "978" + isbn[:9]
+		return gtin + string(gtinCheckDigit(gtin)), nil
+	case 13:
+		if !ValidateISBN13(isbn) {
+			return "", fmt.Errorf("invalid ISBN-13: %q", s)
+		}
+		return isbn, nil
+	default:
+		return "", fmt.Errorf("invalid ISBN %q: expected 10 or 13 digits, got %d", s, len(isbn))
+	}
+}
+
+// ValidateISBN10 returns true if s is a valid ISBN-10 (hyphens and spaces are ignored). The last character may be 'X'.
+func ValidateISBN10(s string) bool {
+	isbn := stripISBNSeparators(s)
+	if len(isbn) != 10 {
+		return false
+	}
+	var sum int
+	for i, c := range []byte(isbn) {
+		var value int
+		switch {
+		case c >= '0' && c <= '9':
+			value = int(c - '0')
+		case i == 9 && (c == 'X' || c == 'x'):
+			value = 10
+		default:
+			return false
+		}
+		sum += (10 - i) * value
+	}
+	return sum%11 == 0
+}
+
+// ValidateISBN13 returns true if s is a valid ISBN-13 (hyphens and spaces are ignored): 978 or 979 prefix and a valid check digit.
+func ValidateISBN13(s string) bool {
+	isbn := stripISBNSeparators(s)
+	if len(isbn) != 13 || !isDigits(isbn) {
+		return false
+	}
+	if !strings.HasPrefix(isbn, "978") && !strings.HasPrefix(isbn, "979") {
+		return false
+	}
+	return gtinCheckDigit(isbn[:12]) == isbn[12]
+}
+
+func stripISBNSeparators(s string) string {
+	return strings.NewReplacer("-", "", " ", "").Replace(strings.TrimSpace(s))
+}
+
+func isDigits(s string) bool {
+	for _, c := range []byte(s) {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// gtinCheckDigit computes the GS1 check digit of a GTIN without its check digit.
+// Weights alternate 3 and 1 starting from the rightmost digit.
+func gtinCheckDigit(digits string) byte {
+	var sum int
+	weight := 3
+	for i := len(digits) - 1; i >= 0; i-- {
+		sum += int(digits[i]-'0') * weight
+		weight = 4 - weight
+	}
+	return byte('0' + (10-sum%10)%10)
+}
+
+// GTIN is a Global Trade Item Number, as stored in the v2.1 GTIN field. It encompasses ISBN, ISSN, EAN and JAN codes.
+// Hyphens and spaces are ignored by all methods.
+type GTIN string
+
+func (g GTIN) digits() string {
+	return stripISBNSeparators(string(g))
+}
+
+// IsEAN13 returns true if the GTIN is made of 13 digits (EAN-13), whatever its prefix.
+func (g GTIN) IsEAN13() bool {
+	digits := g.digits()
+	return len(digits) == 13 && isDigits(digits)
+}
+
+// IsISBN13 returns true if the GTIN is an EAN-13 with the 978 or 979 (Bookland) prefix.
+func (g GTIN) IsISBN13() bool {
+	digits := g.digits()
+	return g.IsEAN13() && (strings.HasPrefix(digits, "978") || strings.HasPrefix(digits, "979"))
+}
+
+// IsISSN returns true if the GTIN is an EAN-13 with the 977 prefix, used for serial publications.
+func (g GTIN) IsISSN() bool {
+	return g.IsEAN13() && strings.HasPrefix(g.digits(), "977")
+}
+
+// IsJAN returns true if the GTIN is an EAN-13 with a Japanese prefix (45 or 49).
+func (g GTIN) IsJAN() bool {
+	digits := g.digits()
+	return g.IsEAN13() && (strings.HasPrefix(digits, "45") || strings.HasPrefix(digits, "49"))
+}
+
+// Validate checks that the GTIN has a valid length (8, 12, 13 or 14 digits) and a valid check digit.
+func (g GTIN) Validate() error {
+	digits := g.digits()
+	if !isDigits(digits) {
+		return fmt.Errorf("GTIN %q must only contain digits", string(g))
+	}
+	switch len(digits) {
+	case 8, 12, 13, 14:
+	default:
+		return fmt.Errorf("GTIN %q must have 8, 12, 13 or 14 digits, got %d", string(g), len(digits))
+	}
+	if expected := gtinCheckDigit(digits[:len(digits)-1]); digits[len(digits)-1] != expected {
+		return fmt.Errorf("GTIN %q has an invalid check digit: expected %c", string(g), expected)
+	}
+	return nil
+}
+
+// Format returns the GTIN with canonical separators. An ISSN GTIN is returned as its 8 characters ISSN ("XXXX-XXXX").
+// An ISBN is returned as "prefix-body-check" (eg. "978-030640615-7"): splitting the body into its group, registrant
+// and publication elements requires the ISBN ranges table which is not embedded. Other GTINs are returned as digits only.
+func (g GTIN) Format() string {
+	digits := g.digits()
+	switch {
+	case g.IsISSN():
+		issn := digits[3:10]
+		return issn[:4] + "-" + issn[4:] + string(issnCheckDigit(issn))
+	case g.IsISBN13():
+		return digits[:3] + "-" + digits[3:12] + "-" + digits[12:]
+	default:
+		return digits
+	}
+}
+
+// issnCheckDigit computes the modulo 11 check digit of the first 7 digits of an ISSN.
+func issnCheckDigit(digits string) byte {
+	var sum int
+	for i, c := range []byte(digits) {
+		sum += int(c-'0') * (8 - i)
+	}
+	check := (11 - sum%11) % 11
+	if check == 10 {
+		return 'X'
+	}
+	return byte('0' + check)
+}
+
+// ValidateISSN checks that s is a valid ISSN: 8 characters formatted as XXXX-XXXX (the hyphen is optional),
+// the last one being a modulo 11 check digit which may be 'X'.
+func ValidateISSN(s string) error {
+	issn := strings.ToUpper(strings.Replace(strings.TrimSpace(s), "-", "", 1))
+	if len(issn) != 8 {
+		return fmt.Errorf("ISSN %q must have 8 characters, got %d", s, len(issn))
+	}
+	if !isDigits(issn[:7]) || !(isDigits(issn[7:]) || issn[7] == 'X') {
+		return fmt.Errorf("ISSN %q must be formatted as XXXX-XXXX with only digits and an optional X check digit", s)
+	}
+	if expected := issnCheckDigit(issn[:7]); issn[7] != expected {
+		return fmt.Errorf("ISSN %q has an invalid check digit: expected %c", s, expected)
+	}
+	return nil
+}
+
+// ValidateGTIN checks the value of a GTIN field. As ISSN are commonly stored as is instead of their 977 prefixed
+// GTIN form (which does not carry the ISSN check digit), values formatted as an ISSN (XXXX-XXXX) are validated with ValidateISSN.
+func ValidateGTIN(s string) error {
+	if len(s) == 9 && s[4] == '-' {
+		return ValidateISSN(s)
+	}
+	return GTIN(s).Validate()
+}
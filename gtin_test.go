@@ -0,0 +1,113 @@
+package comicinfo
+
+import (
+	"testing"
+)
+
+func TestValidateISSN(t *testing.T) {
+	tests := []struct {
+		issn  string
+		valid bool
+	}{
+		{"1234-5679", true},
+		{"12345679", true},
+		{"0317-8471", true},
+		{"2434-561X", true},
+		{"2434-561x", true},
+		{"1234-5678", false},
+		{"1234-567", false},
+		{"1234-56790", false},
+		{"12A4-5679", false},
+		{"123X-5679", false},
+		{"", false},
+	}
+	for _, test := range tests {
+		if err := ValidateISSN(test.issn); (err == nil) != test.valid {
+			t.Errorf("ValidateISSN(%q): expected valid=%t, got error: %v", test.issn, test.valid, err)
+		}
+	}
+}
+
+func TestValidateGTIN(t *testing.T) {
+	tests := []struct {
+		gtin  string
+		valid bool
+	}{
+		{"9780306406157", true},
+		{"978-0-306-40615-7", true},
+		{"9770317847001", true},
+		{"4006381333931", true},
+		{"96385074", true},
+		{"036000291452", true},
+		{"10012345000017", true},
+		{"1234-5679", true},
+		{"9780306406158", false},
+		{"96385075", false},
+		{"1234-5678", false},
+		{"978030640615", false},
+		{"97803064061A7", false},
+		{"", false},
+	}
+	for _, test := range tests {
+		if err := ValidateGTIN(test.gtin); (err == nil) != test.valid {
+			t.Errorf("ValidateGTIN(%q): expected valid=%t, got error: %v", test.gtin, test.valid, err)
+		}
+	}
+}
+
+func TestValidateISBN(t *testing.T) {
+	tests := []struct {
+		isbn    string
+		isbn10  bool
+		isbn13  bool
+		gtin    string
+		invalid bool
+	}{
+		{isbn: "0-306-40615-2", isbn10: true, gtin: "9780306406157"},
+		{isbn: "080442957X", isbn10: true, gtin: "9780804429573"},
+		{isbn: "080442957x", isbn10: true, gtin: "9780804429573"},
+		{isbn: "978-0-306-40615-7", isbn13: true, gtin: "9780306406157"},
+		{isbn: "979-10-90636-07-1", isbn13: true, gtin: "9791090636071"},
+		{isbn: "0-306-40615-3", invalid: true},
+		{isbn: "978-0-306-40615-8", invalid: true},
+		{isbn: "977-0-306-40615-7", invalid: true},
+		{isbn: "X804429570", invalid: true},
+		{isbn: "12345", invalid: true},
+	}
+	for _, test := range tests {
+		if got := ValidateISBN10(test.isbn); got != test.isbn10 {
+			t.Errorf("ValidateISBN10(%q): expected %t, got %t", test.isbn, test.isbn10, got)
+		}
+		if got := ValidateISBN13(test.isbn); got != test.isbn13 {
+			t.Errorf("ValidateISBN13(%q): expected %t, got %t", test.isbn, test.isbn13, got)
+		}
+		gtin, err := ParseISBN(test.isbn)
+		if test.invalid {
+			if err == nil {
+				t.Errorf("ParseISBN(%q): expected an error, got %q", test.isbn, gtin)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseISBN(%q): unexpected error: %s", test.isbn, err)
+		} else if gtin != test.gtin {
+			t.Errorf("ParseISBN(%q): expected %q, got %q", test.isbn, test.gtin, gtin)
+		}
+	}
+}
+
+func TestGTINFormat(t *testing.T) {
+	tests := []struct {
+		gtin     GTIN
+		expected string
+	}{
+		{"9770317847001", "0317-8471"},
+		{"9780306406157", "978-030640615-7"},
+		{"4006381333931", "4006381333931"},
+	}
+	for _, test := range tests {
+		if got := test.gtin.Format(); got != test.expected {
+			t.Errorf("GTIN(%q).Format(): expected %q, got %q", test.gtin, test.expected, got)
+		}
+	}
+}
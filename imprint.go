@@ -0,0 +1,65 @@
+package comicinfo
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// KnownImprints maps well known imprints to their publisher, checked (case-insensitively) by ValidateImprint.
+var KnownImprints = map[string]string{
+	"Vertigo":        "DC",
+	"DC Black Label": "DC",
+	"WildStorm":      "DC",
+	"Milestone":      "DC",
+	"MAX":            "Marvel",
+	"Marvel Knights": "Marvel",
+	"Icon":           "Marvel",
+	"Epic":           "Marvel",
+	"Top Cow":        "Image",
+	"Skybound":       "Image",
+	"Shadowline":     "Image",
+	"Berger Books":   "Dark Horse",
+}
+
+// ValidateImprint checks that imprint is not a copy of publisher, a common mistake of metadata tools, and that known
+// imprints (see KnownImprints) are associated with their actual publisher. Publisher names are compared without their
+// common suffixes, eg. "DC Comics" matches "DC". An empty imprint is valid.
+func ValidateImprint(publisher, imprint string) error {
+	imprint = strings.TrimSpace(imprint)
+	if imprint == "" {
+		return nil
+	}
+	if publisherKey(imprint) == publisherKey(publisher) {
+		return fmt.Errorf("imprint %q is identical to the publisher", imprint)
+	}
+	if strings.TrimSpace(publisher) == "" {
+		return errors.New("imprint is set but publisher is empty")
+	}
+	for known, owner := range KnownImprints {
+		if strings.EqualFold(known, imprint) && publisherKey(owner) != publisherKey(publisher) {
+			return fmt.Errorf("imprint %q belongs to %q, not %q", imprint, owner, strings.TrimSpace(publisher))
+		}
+	}
+	return nil
+}
+
+// publisherKey returns the comparison key of a publisher name: lowercased and without its common suffixes.
+func publisherKey(name string) string {
+	key := strings.ToLower(strings.TrimSpace(name))
+	for _, suffix := range []string{" comics", " entertainment", " publishing"} {
+		key = strings.TrimSuffix(key, suffix)
+	}
+	return key
+}
+
+// SetImprintForPublisher sets the Publisher and Imprint fields after checking them with ValidateImprint.
+// Nothing is modified if an error is returned.
+func (ci *ComicInfov2) SetImprintForPublisher(publisher, imprint string) error {
+	if err := ValidateImprint(publisher, imprint); err != nil {
+		return err
+	}
+	ci.Publisher = strings.TrimSpace(publisher)
+	ci.Imprint = strings.TrimSpace(imprint)
+	return nil
+}
@@ -0,0 +1,213 @@
+//go:build integration
+
+package comicinfo
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+// The integration tests download the official XSD of each version and check the encoded output against it.
+// No XSD validator is available without CGO or a third party dependency, so the checks cover what the encoder
+// controls: the root element, the schema location, the elements names and order, the page attributes names
+// and the enumerated values. Run them with: go test -tags integration
+
+// xmlNode is a generic XML element, used to walk both the XSD and the encoded documents.
+type xmlNode struct {
+	XMLName  xml.Name
+	Attrs    []xml.Attr `xml:",any,attr"`
+	Content  string     `xml:",chardata"`
+	Children []xmlNode  `xml:",any"`
+}
+
+func (n xmlNode) attr(name string) string {
+	for _, attr := range n.Attrs {
+		if attr.Name.Local == name {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+// find returns the first descendant (or n itself) named local with the given name attribute (any if empty).
+func (n xmlNode) find(local, name string) (xmlNode, bool) {
+	if n.XMLName.Local == local && (name == "" || n.attr("name") == name) {
+		return n, true
+	}
+	for _, child := range n.Children {
+		if found, ok := child.find(local, name); ok {
+			return found, true
+		}
+	}
+	return xmlNode{}, false
+}
+
+// findAll appends to nodes every descendant (or n itself) named local.
+func (n xmlNode) findAll(local string, nodes []xmlNode) []xmlNode {
+	if n.XMLName.Local == local {
+		nodes = append(nodes, n)
+	}
+	for _, child := range n.Children {
+		nodes = child.findAll(local, nodes)
+	}
+	return nodes
+}
+
+// schema is the subset of an XSD needed to check the encoded documents.
+type schema struct {
+	elements       []string            // ComicInfo sequence, in order
+	elementTypes   map[string]string   // ComicInfo element name to type name
+	pageAttributes map[string]string   // ComicPageInfo attribute name to type name
+	enumerations   map[string][]string // simple type name to allowed values
+	lists          map[string]bool     // simple types which are whitespace separated lists
+}
+
+func downloadSchema(t *testing.T, url string) schema {
+	t.Helper()
+	client := http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Get(url)
+	if err != nil {
+		t.Fatalf("failed to download %s: %s", url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("failed to download %s: %s", url, resp.Status)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("failed to read %s: %s", url, err)
+	}
+	var root xmlNode
+	if err = xml.Unmarshal(data, &root); err != nil {
+		t.Fatalf("failed to parse %s: %s", url, err)
+	}
+	s := schema{
+		elementTypes:   make(map[string]string),
+		pageAttributes: make(map[string]string),
+		enumerations:   make(map[string][]string),
+		lists:          make(map[string]bool),
+	}
+	comicInfo, found := root.find("complexType", "ComicInfo")
+	if !found {
+		t.Fatalf("%s: ComicInfo complex type not found", url)
+	}
+	for _, element := range comicInfo.findAll("element", nil) {
+		s.elements = append(s.elements, element.attr("name"))
+		s.elementTypes[element.attr("name")] = element.attr("type")
+	}
+	pageInfo, found := root.find("complexType", "ComicPageInfo")
+	if !found {
+		t.Fatalf("%s: ComicPageInfo complex type not found", url)
+	}
+	for _, attribute := range pageInfo.findAll("attribute", nil) {
+		s.pageAttributes[attribute.attr("name")] = attribute.attr("type")
+	}
+	for _, simpleType := range root.findAll("simpleType", nil) {
+		name := simpleType.attr("name")
+		if name == "" {
+			continue // anonymous, handled with its named parent
+		}
+		for _, enumeration := range simpleType.findAll("enumeration", nil) {
+			s.enumerations[name] = append(s.enumerations[name], enumeration.attr("value"))
+		}
+		_, s.lists[name] = simpleType.find("list", "")
+	}
+	return s
+}
+
+// checkValue reports an error if value is not allowed by the enumerated simple type typeName.
+func (s schema) checkValue(typeName, value string) error {
+	allowed, enumerated := s.enumerations[typeName]
+	if !enumerated {
+		return nil
+	}
+	values := []string{value}
+	if s.lists[typeName] {
+		values = strings.Fields(value)
+	}
+	for _, v := range values {
+		if !slices.Contains(allowed, v) {
+			return fmt.Errorf("value %q is not allowed by %s", v, typeName)
+		}
+	}
+	return nil
+}
+
+func checkAgainstSchema(t *testing.T, encoded []byte, schemaURL string) {
+	t.Helper()
+	var document xmlNode
+	if err := xml.Unmarshal(encoded, &document); err != nil {
+		t.Fatalf("failed to parse encoded document: %s", err)
+	}
+	if document.XMLName.Local != "ComicInfo" {
+		t.Errorf("root element: expected ComicInfo, got %s", document.XMLName.Local)
+	}
+	location := document.attr("schemaLocation")
+	if location != schemaURL {
+		t.Fatalf("schemaLocation: expected %q, got %q", schemaURL, location)
+	}
+	s := downloadSchema(t, location)
+	previous := -1
+	for _, element := range document.Children {
+		name := element.XMLName.Local
+		index := slices.Index(s.elements, name)
+		switch {
+		case index == -1:
+			t.Errorf("element %s is not defined by the schema", name)
+			continue
+		case index <= previous:
+			t.Errorf("element %s is out of the schema sequence order", name)
+		}
+		previous = index
+		if err := s.checkValue(s.elementTypes[name], strings.TrimSpace(element.Content)); err != nil {
+			t.Errorf("element %s: %s", name, err)
+		}
+	}
+	pages, _ := document.find("Pages", "")
+	for i, page := range pages.Children {
+		if page.XMLName.Local != "Page" {
+			t.Errorf("Pages child %d: expected a Page element, got %s", i, page.XMLName.Local)
+		}
+		for _, attr := range page.Attrs {
+			typeName, defined := s.pageAttributes[attr.Name.Local]
+			if !defined {
+				t.Errorf("page %d: attribute %s is not defined by the schema", i, attr.Name.Local)
+				continue
+			}
+			if err := s.checkValue(typeName, attr.Value); err != nil {
+				t.Errorf("page %d: attribute %s: %s", i, attr.Name.Local, err)
+			}
+		}
+	}
+}
+
+func TestSchemaV1(t *testing.T) {
+	var encoded bytes.Buffer
+	if err := newTestComicInfov1().Encode(&encoded); err != nil {
+		t.Fatalf("failed to encode: %s", err)
+	}
+	checkAgainstSchema(t, encoded.Bytes(), V1.SchemaURL())
+}
+
+func TestSchemaV2(t *testing.T) {
+	var encoded bytes.Buffer
+	if err := newTestComicInfov2().Encode(&encoded); err != nil {
+		t.Fatalf("failed to encode: %s", err)
+	}
+	checkAgainstSchema(t, encoded.Bytes(), V2.SchemaURL())
+}
+
+func TestSchemaV21(t *testing.T) {
+	var encoded bytes.Buffer
+	if err := newTestComicInfov21().Encode(&encoded); err != nil {
+		t.Fatalf("failed to encode: %s", err)
+	}
+	checkAgainstSchema(t, encoded.Bytes(), V21.SchemaURL())
+}
@@ -0,0 +1,105 @@
+package comicinfo
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// IssueNumber represents a book number as used by publishers: integer ("5"), fractional ("1.5") or special ("Annual").
+// The ComicInfo schemas define Number as a string but the version structs keep it as an int for backward compatibility:
+// ComicInfov2 carries the other forms within its IssueNumberStr field.
+type IssueNumber string
+
+// Float64 returns the numeric value of the issue number. The boolean is false for non numeric designators like "Annual".
+func (in IssueNumber) Float64() (float64, bool) {
+	value, err := strconv.ParseFloat(strings.TrimSpace(string(in)), 64)
+	if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+		return 0, false
+	}
+	return value, true
+}
+
+// IsInteger returns true if the issue number is a plain integer, eg. "5" but not "1.5" or "Annual".
+func (in IssueNumber) IsInteger() bool {
+	_, err := strconv.Atoi(strings.TrimSpace(string(in)))
+	return err == nil
+}
+
+// ParseIssueNumber returns the issue number represented by s: an integer, a decimal number or a special designator like "Annual".
+func ParseIssueNumber(s string) (IssueNumber, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return "", errors.New("issue number can not be empty")
+	}
+	return IssueNumber(s), nil
+}
+
+// Less reports whether in sorts before other in the natural order: numeric issue numbers are sorted by value
+// (so 1 < 1.5 < 2 < 10) and come before special designators, which are sorted alphabetically (case-insensitive).
+func (in IssueNumber) Less(other IssueNumber) bool {
+	a, aNumeric := in.Float64()
+	b, bNumeric := other.Float64()
+	switch {
+	case aNumeric && bNumeric:
+		return a < b
+	case aNumeric != bNumeric:
+		return aNumeric
+	default:
+		return strings.ToLower(strings.TrimSpace(string(in))) < strings.ToLower(strings.TrimSpace(string(other)))
+	}
+}
+
+// SortComicInfov2ByNumber returns a copy of comics sorted by issue number (see IssueNumber.Less), the original slice is not modified.
+// The IssueNumberStr field is used when set, Number otherwise. Books with the same number keep their relative order.
+func SortComicInfov2ByNumber(comics []ComicInfov2) []ComicInfov2 {
+	sorted := slices.Clone(comics)
+	slices.SortStableFunc(sorted, func(a, b ComicInfov2) int {
+		switch an, bn := a.issueNumber(), b.issueNumber(); {
+		case an.Less(bn):
+			return -1
+		case bn.Less(an):
+			return 1
+		default:
+			return 0
+		}
+	})
+	return sorted
+}
+
+// ComicInfov2Less reports whether a sorts before b in a library: by Series alphabetically (case-insensitive), then by Volume,
+// then by issue number in the natural order of IssueNumber.Less (so issue #0 comes before #1).
+func ComicInfov2Less(a, b ComicInfov2) bool {
+	if as, bs := strings.ToLower(strings.TrimSpace(a.Series)), strings.ToLower(strings.TrimSpace(b.Series)); as != bs {
+		return as < bs
+	}
+	if a.Volume != b.Volume {
+		return a.Volume < b.Volume
+	}
+	return a.issueNumber().Less(b.issueNumber())
+}
+
+// SortBySeriesAndNumber sorts comics in place using ComicInfov2Less. Equivalent books keep their relative order.
+func SortBySeriesAndNumber(comics []ComicInfov2) {
+	slices.SortStableFunc(comics, compareSeriesAndNumber)
+}
+
+// SortedBySeriesAndNumber returns a copy of comics sorted using ComicInfov2Less, the original slice is not modified.
+func SortedBySeriesAndNumber(comics []ComicInfov2) []ComicInfov2 {
+	sorted := slices.Clone(comics)
+	SortBySeriesAndNumber(sorted)
+	return sorted
+}
+
+func compareSeriesAndNumber(a, b ComicInfov2) int {
+	switch {
+	case ComicInfov2Less(a, b):
+		return -1
+	case ComicInfov2Less(b, a):
+		return 1
+	default:
+		return 0
+	}
+}
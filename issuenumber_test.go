@@ -0,0 +1,64 @@
+package comicinfo
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+func TestIssueNumberStrXML(t *testing.T) {
+	for _, number := range []IssueNumber{"12.5", "Annual", "1/2", "<Special> & more"} {
+		ci := newTestComicInfov2()
+		ci.IssueNumberStr = number
+		var encoded bytes.Buffer
+		if err := ci.Encode(&encoded); err != nil {
+			t.Fatalf("Encode failed: %s", err)
+		}
+		var element bytes.Buffer
+		if err := xml.EscapeText(&element, []byte(number)); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(encoded.String(), "<Number>"+element.String()+"</Number>") {
+			t.Errorf("%q: expected it within the Number element:\n%s", number, encoded.String())
+		}
+		var decoded ComicInfov2
+		if err := xml.Unmarshal(encoded.Bytes(), &decoded); err != nil {
+			t.Fatalf("%q: failed to decode: %s", number, err)
+		}
+		if decoded.IssueNumberStr != number || decoded.Number != 0 {
+			t.Errorf("%q: decoded as Number %d and IssueNumberStr %q", number, decoded.Number, decoded.IssueNumberStr)
+		}
+	}
+}
+
+func TestIssueNumberStrDecodeInteger(t *testing.T) {
+	var ci ComicInfov2
+	if err := xml.Unmarshal([]byte("<ComicInfo><Number> 7 </Number></ComicInfo>"), &ci); err != nil {
+		t.Fatalf("failed to decode: %s", err)
+	}
+	if ci.Number != 7 || ci.IssueNumberStr != "" {
+		t.Errorf("expected Number 7 without IssueNumberStr, got %d and %q", ci.Number, ci.IssueNumberStr)
+	}
+}
+
+// TestIssueNumberStrOutput checks that writing IssueNumberStr does not alter the rest of the document.
+func TestIssueNumberStrOutput(t *testing.T) {
+	withNumber := newTestComicInfov2()
+	withNumber.Number = 12
+	withString := withNumber
+	withString.Number = 0
+	withString.IssueNumberStr = "12"
+	for _, opts := range []EncodeOptions{{}, {Compact: true}, {OmitSchema: true}, {IncludeExtensions: true}} {
+		var expected, got bytes.Buffer
+		if err := withNumber.EncodeWithOptions(&expected, opts); err != nil {
+			t.Fatalf("EncodeWithOptions failed: %s", err)
+		}
+		if err := withString.EncodeWithOptions(&got, opts); err != nil {
+			t.Fatalf("EncodeWithOptions failed: %s", err)
+		}
+		if !bytes.Equal(expected.Bytes(), got.Bytes()) {
+			t.Errorf("%+v: outputs differ:\n%s\n---\n%s", opts, expected.String(), got.String())
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package comicinfo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// EncodeJSON writes the ComicInfo as JSON, using the XML element names as keys. It validates the ComicInfo before encoding it.
+func (ci ComicInfov2) EncodeJSON(output io.Writer) (err error) {
+	if output == nil {
+		return errors.New("output cannot be nil")
+	}
+	if err = ci.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+	type Mask ComicInfov2 // only rely on the struct fields
+	if err = json.NewEncoder(output).Encode(Mask(ci)); err != nil {
+		return fmt.Errorf("failed to encode ComicInfo v2 JSON: %w", err)
+	}
+	return
+}
+
+// DecodeJSON reads a ComicInfo v2 written by EncodeJSON and validates it.
+func DecodeJSON(input io.Reader) (ci ComicInfov2, err error) {
+	if input == nil {
+		return ci, errors.New("input cannot be nil")
+	}
+	type Mask ComicInfov2
+	var decoded Mask
+	if err = json.NewDecoder(input).Decode(&decoded); err != nil {
+		return ci, fmt.Errorf("failed to decode ComicInfo v2 JSON: %w", err)
+	}
+	ci = ComicInfov2(decoded)
+	if err = ci.Validate(); err != nil {
+		return ci, fmt.Errorf("validation failed: %w", err)
+	}
+	return
+}
+
+// MarshalJSON implements the json.Marshaler interface to encode the struct fields instead of the MarshalText XML document.
+func (ci ComicInfov2) MarshalJSON() ([]byte, error) {
+	type Mask ComicInfov2
+	return json.Marshal(Mask(ci))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface to decode the struct fields instead of relying on UnmarshalText.
+func (ci *ComicInfov2) UnmarshalJSON(data []byte) error {
+	type Mask ComicInfov2
+	return json.Unmarshal(data, (*Mask)(ci))
+}
+
+// EncodeJSON writes the ComicInfo as JSON, using the XML element names as keys. It validates the ComicInfo before encoding it.
+func (ci ComicInfov21) EncodeJSON(output io.Writer) (err error) {
+	if output == nil {
+		return errors.New("output cannot be nil")
+	}
+	if err = ci.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+	type Mask ComicInfov21
+	if err = json.NewEncoder(output).Encode(Mask(ci)); err != nil {
+		return fmt.Errorf("failed to encode ComicInfo v2.1 JSON: %w", err)
+	}
+	return
+}
+
+// DecodeJSONV21 reads a ComicInfo v2.1 written by EncodeJSON and validates it. Unknown keys are ignored.
+func DecodeJSONV21(input io.Reader) (ci ComicInfov21, err error) {
+	return decodeJSONV21(input, false)
+}
+
+// DecodeJSONV21Strict is DecodeJSONV21 but fails on any key which is not a v2.1 field,
+// for example a v2 only field like IssueNumberStr which would otherwise be silently dropped.
+func DecodeJSONV21Strict(input io.Reader) (ci ComicInfov21, err error) {
+	return decodeJSONV21(input, true)
+}
+
+func decodeJSONV21(input io.Reader, strict bool) (ci ComicInfov21, err error) {
+	if input == nil {
+		return ci, errors.New("input cannot be nil")
+	}
+	type Mask ComicInfov21
+	var decoded Mask
+	decoder := json.NewDecoder(input)
+	if strict {
+		decoder.DisallowUnknownFields()
+	}
+	if err = decoder.Decode(&decoded); err != nil {
+		return ci, fmt.Errorf("failed to decode ComicInfo v2.1 JSON: %w", err)
+	}
+	ci = ComicInfov21(decoded)
+	if err = ci.Validate(); err != nil {
+		return ci, fmt.Errorf("validation failed: %w", err)
+	}
+	return
+}
@@ -0,0 +1,75 @@
+package comicinfo
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestJSONRoundTripV2(t *testing.T) {
+	ci := newTestComicInfov2()
+	var encoded bytes.Buffer
+	if err := ci.EncodeJSON(&encoded); err != nil {
+		t.Fatalf("EncodeJSON failed: %s", err)
+	}
+	decoded, err := DecodeJSON(&encoded)
+	if err != nil {
+		t.Fatalf("DecodeJSON failed: %s", err)
+	}
+	if !reflect.DeepEqual(ci, decoded) {
+		t.Fatalf("round trip mismatch:\n%+v\n---\n%+v", ci, decoded)
+	}
+}
+
+func TestJSONPagesKey(t *testing.T) {
+	data, err := json.Marshal(newTestComicInfov2())
+	if err != nil {
+		t.Fatalf("json.Marshal failed: %s", err)
+	}
+	var raw struct {
+		Pages map[string]json.RawMessage
+	}
+	if err = json.Unmarshal(data, &raw); err != nil {
+		t.Fatalf("json.Unmarshal failed: %s", err)
+	}
+	if _, found := raw.Pages["Page"]; !found || len(raw.Pages) != 1 {
+		t.Errorf("expected the pages list under the XML element name \"Page\", got keys %v", reflect.ValueOf(raw.Pages).MapKeys())
+	}
+}
+
+func TestJSONRoundTripV21(t *testing.T) {
+	ci := newTestComicInfov21()
+	var encoded bytes.Buffer
+	if err := ci.EncodeJSON(&encoded); err != nil {
+		t.Fatalf("EncodeJSON failed: %s", err)
+	}
+	for name, decode := range map[string]func(io.Reader) (ComicInfov21, error){
+		"DecodeJSONV21":       DecodeJSONV21,
+		"DecodeJSONV21Strict": DecodeJSONV21Strict,
+	} {
+		decoded, err := decode(bytes.NewReader(encoded.Bytes()))
+		if err != nil {
+			t.Fatalf("%s failed: %s", name, err)
+		}
+		if !reflect.DeepEqual(ci, decoded) {
+			t.Fatalf("%s round trip mismatch:\n%+v\n---\n%+v", name, ci, decoded)
+		}
+	}
+}
+
+func TestDecodeJSONV21StrictRejectsV2Fields(t *testing.T) {
+	input := `{"Title":"The Last Stand","IssueNumberStr":"12.5"}`
+	if _, err := DecodeJSONV21Strict(strings.NewReader(input)); err == nil {
+		t.Error("DecodeJSONV21Strict must reject the v2 only IssueNumberStr field")
+	}
+	ci, err := DecodeJSONV21(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("DecodeJSONV21 must ignore unknown fields: %s", err)
+	}
+	if ci.Title != "The Last Stand" {
+		t.Errorf("expected the known fields to be decoded, got Title %q", ci.Title)
+	}
+}
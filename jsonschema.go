@@ -0,0 +1,142 @@
+package comicinfo
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+const (
+	jsonSchemaDraft7 = "http://json-schema.org/draft-07/schema#"
+	// JSON Schema has no format for a space separated list of URLs nor for BCP 47 tags: use patterns instead.
+	webPattern      = `^(\S+( \S+)*)?$`
+	languagePattern = `^([A-Za-z]{2,3}(-[A-Za-z0-9]{1,8})*)?$`
+)
+
+var jsonSchemaEnums = map[reflect.Type][]string{
+	reflect.TypeOf(YesNo("")): {"", string(Unknown), string(No), string(Yes)},
+	reflect.TypeOf(Manga("")): {"", string(MangaUnknown), string(MangaNo), string(MangaYes), string(MangaYesAndRightToLeft)},
+	reflect.TypeOf(AgeRating("")): {"", string(AgeRatingUnknown), string(AgeRatingAdultsOnly18Plus), string(AgeRatingEarlyChildhood),
+		string(AgeRatingEveryone), string(AgeRatingEveryone10Plus), string(AgeRatingG), string(AgeRatingKidsToAdults), string(AgeRatingM),
+		string(AgeRatingMA15Plus), string(AgeRatingMature17Plus), string(AgeRatingPG), string(AgeRatingR18Plus),
+		string(AgeRatingRatingPending), string(AgeRatingTeen), string(AgeRatingX18Plus)},
+	reflect.TypeOf(PageType("")): {string(PageTypeFrontCover), string(PageTypeInnerCover), string(PageTypeRoundup), string(PageTypeStory),
+		string(PageTypeAdvertisement), string(PageTypeEditorial), string(PageTypeLetters), string(PageTypePreview),
+		string(PageTypeBackCover), string(PageTypeOther), string(PageTypeDeleted)},
+}
+
+// GenerateJSONSchema produces a JSON Schema (draft 7) describing the JSON representation (as produced by encoding/json)
+// of the struct of the given version. Zero values are accepted for every field as they represent unset values.
+func GenerateJSONSchema(v Version) ([]byte, error) {
+	var (
+		ci    reflect.Type
+		title string
+	)
+	switch v {
+	case V1:
+		ci, title = reflect.TypeOf(ComicInfov1{}), "ComicInfo v1.0"
+	case V2:
+		ci, title = reflect.TypeOf(ComicInfov2{}), "ComicInfo v2.0"
+	case V21:
+		ci, title = reflect.TypeOf(ComicInfov21{}), "ComicInfo v2.1 DRAFT"
+	default:
+		return nil, fmt.Errorf("unknown version %d", v)
+	}
+	schema := jsonSchemaObject(ci, fieldDescriptions)
+	schema["$schema"] = jsonSchemaDraft7
+	schema["title"] = title
+	output, err := json.MarshalIndent(schema, "", "\t")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal JSON schema: %w", err)
+	}
+	return output, nil
+}
+
+// jsonSchemaObject returns the schema of a struct type, using descriptions indexed by XML names.
+func jsonSchemaObject(t reflect.Type, descriptions map[string]string) map[string]interface{} {
+	properties := make(map[string]interface{}, t.NumField())
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if field.Tag.Get("json") == "-" {
+			continue
+		}
+		property := jsonSchemaType(field.Type)
+		switch name := xmlFieldName(field); name {
+		case "Month":
+			property["minimum"], property["maximum"] = 0, 12
+		case "Day":
+			property["minimum"], property["maximum"] = 0, 31
+		case "Web":
+			property["pattern"] = webPattern
+		case "LanguageISO":
+			property["pattern"] = languagePattern
+		case "Rating":
+			property["minimum"], property["maximum"] = 0, 10
+		case "ImageSize":
+			property["minimum"] = 0
+		case "ImageWidth", "ImageHeight":
+			property["minimum"] = -1
+			property["not"] = map[string]interface{}{"const": 0}
+		}
+		if description, found := descriptions[xmlFieldName(field)]; found {
+			property["description"] = description
+		}
+		properties[jsonFieldName(field)] = property
+	}
+	return map[string]interface{}{
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+}
+
+// jsonFieldName returns the JSON key of a struct field as used by encoding/json: the json tag name if any, the field name otherwise.
+func jsonFieldName(field reflect.StructField) string {
+	if name, _, _ := strings.Cut(field.Tag.Get("json"), ","); name != "" {
+		return name
+	}
+	return field.Name
+}
+
+func jsonSchemaType(t reflect.Type) map[string]interface{} {
+	if enum, found := jsonSchemaEnums[t]; found {
+		return map[string]interface{}{
+			"type": "string",
+			"enum": enum,
+		}
+	}
+	switch t {
+	case reflect.TypeOf(CommunityRating(0)), reflect.TypeOf(CommunityRatingV21(0)):
+		return map[string]interface{}{
+			"type":    "number",
+			"minimum": 0,
+			"maximum": 5,
+		}
+	case reflect.TypeOf(PageV2{}), reflect.TypeOf(Page{}):
+		return jsonSchemaObject(t, pageAttributeDescriptions)
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Int:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Pointer:
+		schema := jsonSchemaType(t.Elem())
+		schema["type"] = []interface{}{schema["type"], "null"}
+		return schema
+	case reflect.Slice:
+		return map[string]interface{}{
+			"type":  []string{"array", "null"},
+			"items": jsonSchemaType(t.Elem()),
+		}
+	case reflect.Struct:
+		return jsonSchemaObject(t, nil)
+	default:
+		return map[string]interface{}{}
+	}
+}
@@ -0,0 +1,186 @@
+package comicinfo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"testing"
+)
+
+// validateJSONSchema checks value (as decoded by encoding/json) against schema. Only the keywords produced by
+// GenerateJSONSchema are supported: type, enum, properties, additionalProperties, items, minimum, maximum, pattern and not/const.
+func validateJSONSchema(schema map[string]interface{}, value interface{}, path string) error {
+	if err := checkJSONType(schema["type"], value); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	if enum, found := schema["enum"].([]interface{}); found && !slices.Contains(enum, value) {
+		return fmt.Errorf("%s: %v is not within %v", path, value, enum)
+	}
+	if not, found := schema["not"].(map[string]interface{}); found {
+		if constant, found := not["const"]; found && constant == value {
+			return fmt.Errorf("%s: %v is not allowed", path, value)
+		}
+	}
+	switch typed := value.(type) {
+	case float64:
+		if minimum, found := schema["minimum"].(float64); found && typed < minimum {
+			return fmt.Errorf("%s: %v is lower than %v", path, typed, minimum)
+		}
+		if maximum, found := schema["maximum"].(float64); found && typed > maximum {
+			return fmt.Errorf("%s: %v is greater than %v", path, typed, maximum)
+		}
+	case string:
+		if pattern, found := schema["pattern"].(string); found && !regexp.MustCompile(pattern).MatchString(typed) {
+			return fmt.Errorf("%s: %q does not match %s", path, typed, pattern)
+		}
+	case []interface{}:
+		if items, found := schema["items"].(map[string]interface{}); found {
+			for i, item := range typed {
+				if err := validateJSONSchema(items, item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+					return err
+				}
+			}
+		}
+	case map[string]interface{}:
+		properties, _ := schema["properties"].(map[string]interface{})
+		for key, item := range typed {
+			property, found := properties[key].(map[string]interface{})
+			if !found {
+				if schema["additionalProperties"] == false {
+					return fmt.Errorf("%s: additional property %q", path, key)
+				}
+				continue
+			}
+			if err := validateJSONSchema(property, item, path+"."+key); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func checkJSONType(schemaType, value interface{}) error {
+	var types []interface{}
+	switch typed := schemaType.(type) {
+	case nil:
+		return nil
+	case string:
+		types = []interface{}{typed}
+	case []interface{}:
+		types = typed
+	default:
+		return fmt.Errorf("invalid type keyword %v", schemaType)
+	}
+	var actual string
+	switch typed := value.(type) {
+	case nil:
+		actual = "null"
+	case bool:
+		actual = "boolean"
+	case string:
+		actual = "string"
+	case float64:
+		actual = "number"
+		if typed == float64(int64(typed)) && slices.Contains(types, interface{}("integer")) {
+			actual = "integer"
+		}
+	case []interface{}:
+		actual = "array"
+	case map[string]interface{}:
+		actual = "object"
+	}
+	if !slices.Contains(types, interface{}(actual)) {
+		return fmt.Errorf("%s is not within %v", actual, types)
+	}
+	return nil
+}
+
+func generateTestJSONSchema(t *testing.T, v Version) map[string]interface{} {
+	t.Helper()
+	data, err := GenerateJSONSchema(v)
+	if err != nil {
+		t.Fatalf("GenerateJSONSchema(%s) failed: %s", v, err)
+	}
+	var schema map[string]interface{}
+	if err = json.Unmarshal(data, &schema); err != nil {
+		t.Fatalf("failed to decode the %s schema: %s", v, err)
+	}
+	return schema
+}
+
+func TestEncodeJSONMatchesJSONSchema(t *testing.T) {
+	encode := map[Version]func(*bytes.Buffer) error{
+		V2:  func(b *bytes.Buffer) error { return newTestComicInfov2().EncodeJSON(b) },
+		V21: func(b *bytes.Buffer) error { return newTestComicInfov21().EncodeJSON(b) },
+	}
+	for v, encode := range encode {
+		var encoded bytes.Buffer
+		if err := encode(&encoded); err != nil {
+			t.Fatalf("EncodeJSON %s failed: %s", v, err)
+		}
+		var document interface{}
+		if err := json.Unmarshal(encoded.Bytes(), &document); err != nil {
+			t.Fatalf("failed to decode the %s JSON: %s", v, err)
+		}
+		if err := validateJSONSchema(generateTestJSONSchema(t, v), document, "$"); err != nil {
+			t.Errorf("%s JSON does not match its schema: %s", v, err)
+		}
+	}
+}
+
+// checkJSONSchemaTypes reports every type keyword of schema (and its sub schemas) which is not a string or an array of strings.
+func checkJSONSchemaTypes(t *testing.T, schema interface{}, path string) {
+	t.Helper()
+	switch typed := schema.(type) {
+	case map[string]interface{}:
+		for key, value := range typed {
+			if key != "type" {
+				checkJSONSchemaTypes(t, value, path+"."+key)
+				continue
+			}
+			switch types := value.(type) {
+			case string:
+			case []interface{}:
+				for _, item := range types {
+					if _, ok := item.(string); !ok {
+						t.Errorf("%s.type: %v is not a string", path, item)
+					}
+				}
+			default:
+				t.Errorf("%s.type: %v is neither a string nor an array of strings", path, value)
+			}
+		}
+	case []interface{}:
+		for i, item := range typed {
+			checkJSONSchemaTypes(t, item, fmt.Sprintf("%s[%d]", path, i))
+		}
+	}
+}
+
+func TestGenerateJSONSchemaTypes(t *testing.T) {
+	for _, v := range ListVersions() {
+		checkJSONSchemaTypes(t, generateTestJSONSchema(t, v), v.String())
+	}
+}
+
+func TestJSONSchemaRating(t *testing.T) {
+	schema := generateTestJSONSchema(t, V2)
+	ci := newTestComicInfov2()
+	for _, rating := range []float64{0, 7.5, 10, 10.5, -1} {
+		ci.Rating = &rating
+		data, err := json.Marshal(ci)
+		if err != nil {
+			t.Fatalf("json.Marshal failed: %s", err)
+		}
+		var document interface{}
+		if err = json.Unmarshal(data, &document); err != nil {
+			t.Fatalf("json.Unmarshal failed: %s", err)
+		}
+		err = validateJSONSchema(schema, document, "$")
+		if valid := rating >= 0 && rating <= 10; (err == nil) != valid {
+			t.Errorf("Rating %v: expected valid=%t, got %v", rating, valid, err)
+		}
+	}
+}
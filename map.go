@@ -0,0 +1,91 @@
+package comicinfo
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// ToMap returns the fields of the ComicInfo as strings indexed by XML element name (Go name for non standard fields),
+// for key-value stores. Ints are written as decimal, floats with 2 digits and bools as "true"/"false". The pages list is
+// stored as JSON under the "Pages" key. Unset (zero) fields are omitted, as they are from the XML output.
+func (ci ComicInfov2) ToMap() map[string]string {
+	v := reflect.ValueOf(ci)
+	t := v.Type()
+	m := make(map[string]string, t.NumField())
+	for i := range t.NumField() {
+		field := v.Field(i)
+		if field.IsZero() {
+			continue
+		}
+		if field.Kind() == reflect.Pointer {
+			field = field.Elem()
+		}
+		var value string
+		switch field.Kind() {
+		case reflect.String:
+			value = field.String()
+		case reflect.Int:
+			value = strconv.FormatInt(field.Int(), 10)
+		case reflect.Float64:
+			value = strconv.FormatFloat(field.Float(), 'f', 2, 64)
+		case reflect.Bool:
+			value = strconv.FormatBool(field.Bool())
+		default:
+			data, err := json.Marshal(field.Interface())
+			if err != nil {
+				continue // pages only hold plain values, this can not happen
+			}
+			value = string(data)
+		}
+		m[xmlFieldName(t.Field(i))] = value
+	}
+	return m
+}
+
+// ComicInfov2FromMap is the inverse of ToMap: it parses every entry into the field of the same XML element name
+// and validates the result. An error is returned for unknown keys and unparseable values.
+func ComicInfov2FromMap(m map[string]string) (ci ComicInfov2, err error) {
+	v := reflect.ValueOf(&ci).Elem()
+	for name, value := range m {
+		field, found := fieldByXMLName(v, name)
+		if !found {
+			return ComicInfov2{}, fmt.Errorf("unknown field %q", name)
+		}
+		if field.Kind() == reflect.Pointer {
+			field.Set(reflect.New(field.Type().Elem()))
+			field = field.Elem()
+		}
+		switch field.Kind() {
+		case reflect.String:
+			field.SetString(value)
+		case reflect.Int:
+			var number int64
+			if number, err = strconv.ParseInt(value, 10, 0); err != nil {
+				return ComicInfov2{}, fmt.Errorf("failed to parse %s: %w", name, err)
+			}
+			field.SetInt(number)
+		case reflect.Float64:
+			var number float64
+			if number, err = strconv.ParseFloat(value, 64); err != nil {
+				return ComicInfov2{}, fmt.Errorf("failed to parse %s: %w", name, err)
+			}
+			field.SetFloat(number)
+		case reflect.Bool:
+			var b bool
+			if b, err = strconv.ParseBool(value); err != nil {
+				return ComicInfov2{}, fmt.Errorf("failed to parse %s: %w", name, err)
+			}
+			field.SetBool(b)
+		default:
+			if err = json.Unmarshal([]byte(value), field.Addr().Interface()); err != nil {
+				return ComicInfov2{}, fmt.Errorf("failed to parse %s: %w", name, err)
+			}
+		}
+	}
+	if err = ci.Validate(); err != nil {
+		return ComicInfov2{}, fmt.Errorf("validation failed: %w", err)
+	}
+	return
+}
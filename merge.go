@@ -0,0 +1,68 @@
+package comicinfo
+
+import (
+	"fmt"
+)
+
+// MergeStrategy defines how PagesV2.MergeWithStrategy() handles pages sharing the same Key but not the same Image index.
+type MergeStrategy int
+
+const (
+	MergeStrategyError     MergeStrategy = iota // return an error
+	MergeStrategyKeepBase                       // keep the Image index of the base page
+	MergeStrategyKeepOther                      // use the Image index of the other page
+)
+
+// Merge is MergeWithStrategy with MergeStrategyError.
+func (ps PagesV2) Merge(other PagesV2) (PagesV2, error) {
+	return ps.MergeWithStrategy(other, MergeStrategyError)
+}
+
+// MergeWithStrategy returns a new page list where pages of other are matched with the pages of ps by Key.
+// For matching pages, the non zero fields of the other page override the ones of the base page (DoublePage is true
+// if any of them is). Pages only present within other are appended. Conflicting Image indexes are handled by strategy.
+func (ps PagesV2) MergeWithStrategy(other PagesV2, strategy MergeStrategy) (PagesV2, error) {
+	merged := PagesV2{
+		Pages: make([]PageV2, len(ps.Pages), len(ps.Pages)+len(other.Pages)),
+	}
+	copy(merged.Pages, ps.Pages)
+	positions := make(map[string]int, len(merged.Pages))
+	for i, p := range merged.Pages {
+		positions[p.Key] = i
+	}
+	for _, op := range other.Pages {
+		position, found := positions[op.Key]
+		if !found {
+			positions[op.Key] = len(merged.Pages)
+			merged.Pages = append(merged.Pages, op)
+			continue
+		}
+		base := &merged.Pages[position]
+		if base.Image != op.Image {
+			switch strategy {
+			case MergeStrategyKeepBase:
+			case MergeStrategyKeepOther:
+				base.Image = op.Image
+			default:
+				return PagesV2{}, fmt.Errorf("conflict for page %q: Image index %d in base but %d in other", op.Key, base.Image, op.Image)
+			}
+		}
+		if op.Type != "" {
+			base.Type = op.Type
+		}
+		base.DoublePage = base.DoublePage || op.DoublePage
+		if op.ImageSize != 0 {
+			base.ImageSize = op.ImageSize
+		}
+		if op.Bookmark != "" {
+			base.Bookmark = op.Bookmark
+		}
+		if op.ImageWidth != 0 {
+			base.ImageWidth = op.ImageWidth
+		}
+		if op.ImageHeight != 0 {
+			base.ImageHeight = op.ImageHeight
+		}
+	}
+	return merged, nil
+}
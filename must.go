@@ -0,0 +1,50 @@
+package comicinfo
+
+import (
+	"bytes"
+)
+
+// MustEncodeToBytes returns the ComicInfo v2 XML content of ci and panics if Encode() fails.
+// It is meant for tests and initialization code only: do not use it in production code, use Encode() instead.
+func MustEncodeToBytes(ci ComicInfov2) []byte {
+	var buffer bytes.Buffer
+	if err := ci.Encode(&buffer); err != nil {
+		panic(err)
+	}
+	return buffer.Bytes()
+}
+
+// MustEncodeToString is MustEncodeToBytes returning a string. Do not use it in production code.
+func MustEncodeToString(ci ComicInfov2) string {
+	return string(MustEncodeToBytes(ci))
+}
+
+// MustEncodeV1ToBytes returns the ComicInfo v1 XML content of ci and panics if Encode() fails.
+// It is meant for tests and initialization code only: do not use it in production code, use Encode() instead.
+func MustEncodeV1ToBytes(ci ComicInfov1) []byte {
+	var buffer bytes.Buffer
+	if err := ci.Encode(&buffer); err != nil {
+		panic(err)
+	}
+	return buffer.Bytes()
+}
+
+// MustEncodeV1ToString is MustEncodeV1ToBytes returning a string. Do not use it in production code.
+func MustEncodeV1ToString(ci ComicInfov1) string {
+	return string(MustEncodeV1ToBytes(ci))
+}
+
+// MustEncodeV21ToBytes returns the ComicInfo v2.1 DRAFT XML content of ci and panics if Encode() fails.
+// It is meant for tests and initialization code only: do not use it in production code, use Encode() instead.
+func MustEncodeV21ToBytes(ci ComicInfov21) []byte {
+	var buffer bytes.Buffer
+	if err := ci.Encode(&buffer); err != nil {
+		panic(err)
+	}
+	return buffer.Bytes()
+}
+
+// MustEncodeV21ToString is MustEncodeV21ToBytes returning a string. Do not use it in production code.
+func MustEncodeV21ToString(ci ComicInfov21) string {
+	return string(MustEncodeV21ToBytes(ci))
+}
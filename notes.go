@@ -0,0 +1,86 @@
+package comicinfo
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	notesCreatedByPrefix = "Created by "
+	// NotesAppKey and NotesVersionKey are the keys of the application signature within the map returned by ParseNotesField.
+	NotesAppKey     = "app"
+	NotesVersionKey = "version"
+)
+
+// FormatNotesField returns a structured Notes field signing the application which created the ComicInfo, followed by the
+// extra key-value pairs sorted by key, eg. "Created by MyApp v1.2.3; source=mangadex; date=2024-01-15".
+// The version is omitted if empty. An error is returned if the version does not start with a digit (once stripped of its
+// "v" prefix) or contains spaces, if an extra key is empty or reserved (NotesAppKey, NotesVersionKey), or if the application
+// name or a key contains ";" or "=" or a value contains ";", as ParseNotesField could not read the result back.
+func FormatNotesField(appName, appVersion string, extra map[string]string) (string, error) {
+	var parts []string
+	if appName = strings.TrimSpace(appName); appName != "" {
+		if strings.ContainsAny(appName, ";=") {
+			return "", fmt.Errorf("application name %q must not contain ';' or '='", appName)
+		}
+		signature := notesCreatedByPrefix + appName
+		if appVersion = strings.TrimPrefix(strings.TrimSpace(appVersion), "v"); appVersion != "" {
+			if !isVersion(appVersion) || strings.ContainsAny(appVersion, ";=") {
+				return "", fmt.Errorf("invalid application version %q: must start with a digit and not contain spaces, ';' or '='", appVersion)
+			}
+			signature += " v" + appVersion
+		}
+		parts = append(parts, signature)
+	}
+	keys := make([]string, 0, len(extra))
+	for key := range extra {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		name, value := strings.TrimSpace(key), strings.TrimSpace(extra[key])
+		switch {
+		case name == "":
+			return "", errors.New("extra keys must not be empty")
+		case name == NotesAppKey || name == NotesVersionKey:
+			return "", fmt.Errorf("extra key %q is reserved for the application signature", name)
+		case strings.ContainsAny(name, ";=") || strings.ContainsRune(value, ';'):
+			return "", fmt.Errorf("extra key %q: keys must not contain ';' or '=' and values must not contain ';'", name)
+		}
+		parts = append(parts, name+"="+value)
+	}
+	return strings.Join(parts, "; "), nil
+}
+
+// ParseNotesField is the inverse of FormatNotesField: it returns the key-value pairs of a structured Notes field, the
+// application signature being stored under NotesAppKey and NotesVersionKey (without its "v" prefix).
+// Segments which are neither the signature nor a key-value pair are ignored.
+func ParseNotesField(s string) map[string]string {
+	values := make(map[string]string)
+	for _, part := range strings.Split(s, ";") {
+		part = strings.TrimSpace(part)
+		if key, value, found := strings.Cut(part, "="); found {
+			if key = strings.TrimSpace(key); key != "" {
+				values[key] = strings.TrimSpace(value)
+			}
+			continue
+		}
+		signature, found := strings.CutPrefix(part, notesCreatedByPrefix)
+		if !found {
+			continue
+		}
+		if index := strings.LastIndex(signature, " v"); index != -1 && isVersion(signature[index+2:]) {
+			values[NotesVersionKey] = signature[index+2:]
+			signature = signature[:index]
+		}
+		values[NotesAppKey] = strings.TrimSpace(signature)
+	}
+	return values
+}
+
+// isVersion returns true if s looks like a version number, ie. starts with a digit and has no spaces.
+func isVersion(s string) bool {
+	return s != "" && s[0] >= '0' && s[0] <= '9' && !strings.ContainsRune(s, ' ')
+}
@@ -0,0 +1,96 @@
+package comicinfo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNotesFieldRoundTrip(t *testing.T) {
+	tests := []struct {
+		name       string
+		appName    string
+		appVersion string
+		extra      map[string]string
+		expected   string
+		parsed     map[string]string
+	}{
+		{
+			name: "signature only", appName: "MyApp", appVersion: "1.2.3",
+			expected: "Created by MyApp v1.2.3",
+			parsed:   map[string]string{NotesAppKey: "MyApp", NotesVersionKey: "1.2.3"},
+		},
+		{
+			name: "v prefixed version", appName: "MyApp", appVersion: "v1.2.3",
+			expected: "Created by MyApp v1.2.3",
+			parsed:   map[string]string{NotesAppKey: "MyApp", NotesVersionKey: "1.2.3"},
+		},
+		{
+			name: "pre-release version", appName: "My App", appVersion: "2.0.0-beta.1",
+			expected: "Created by My App v2.0.0-beta.1",
+			parsed:   map[string]string{NotesAppKey: "My App", NotesVersionKey: "2.0.0-beta.1"},
+		},
+		{
+			name: "no version", appName: "MyApp",
+			expected: "Created by MyApp",
+			parsed:   map[string]string{NotesAppKey: "MyApp"},
+		},
+		{
+			name: "extra sorted", appName: "MyApp", appVersion: "1.0",
+			extra:    map[string]string{"source": "mangadex", "date": "2024-01-15"},
+			expected: "Created by MyApp v1.0; date=2024-01-15; source=mangadex",
+			parsed:   map[string]string{NotesAppKey: "MyApp", NotesVersionKey: "1.0", "date": "2024-01-15", "source": "mangadex"},
+		},
+		{
+			name:     "extra only",
+			extra:    map[string]string{" source ": " mangadex "},
+			expected: "source=mangadex",
+			parsed:   map[string]string{"source": "mangadex"},
+		},
+		{
+			name: "value with equal sign", appName: "MyApp",
+			extra:    map[string]string{"query": "a=b"},
+			expected: "Created by MyApp; query=a=b",
+			parsed:   map[string]string{NotesAppKey: "MyApp", "query": "a=b"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			notes, err := FormatNotesField(test.appName, test.appVersion, test.extra)
+			if err != nil {
+				t.Fatalf("FormatNotesField failed: %s", err)
+			}
+			if notes != test.expected {
+				t.Errorf("FormatNotesField: expected %q, got %q", test.expected, notes)
+			}
+			if parsed := ParseNotesField(notes); !reflect.DeepEqual(parsed, test.parsed) {
+				t.Errorf("ParseNotesField: expected %v, got %v", test.parsed, parsed)
+			}
+		})
+	}
+}
+
+func TestFormatNotesFieldErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		appName    string
+		appVersion string
+		extra      map[string]string
+	}{
+		{name: "non numeric version", appName: "MyApp", appVersion: "beta"},
+		{name: "version with spaces", appName: "MyApp", appVersion: "1.0 final"},
+		{name: "version with separator", appName: "MyApp", appVersion: "1.0;x=y"},
+		{name: "app name with separator", appName: "My;App"},
+		{name: "reserved app key", appName: "MyApp", extra: map[string]string{NotesAppKey: "Other"}},
+		{name: "reserved version key", appName: "MyApp", extra: map[string]string{" version ": "2.0"}},
+		{name: "empty key", extra: map[string]string{" ": "value"}},
+		{name: "key with equal sign", extra: map[string]string{"a=b": "value"}},
+		{name: "value with separator", extra: map[string]string{"source": "a; b"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if notes, err := FormatNotesField(test.appName, test.appVersion, test.extra); err == nil {
+				t.Errorf("expected an error, got %q", notes)
+			}
+		})
+	}
+}
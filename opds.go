@@ -0,0 +1,43 @@
+package comicinfo
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// OPDSEntry is a minimal representation of an OPDS catalog entry, covering the fields which map to ComicInfo ones.
+type OPDSEntry struct {
+	Title      string    // atom:title
+	Authors    []string  // atom:author names
+	Summary    string    // atom:summary or atom:content
+	Published  time.Time // atom:published or dc:issued
+	Categories []string  // atom:category labels (or terms)
+	Language   string    // dc:language
+	Publisher  string    // dc:publisher
+	Web        []string  // atom:link href values pointing to reference websites
+}
+
+// FromOPDSEntry maps an OPDS catalog entry to a v2 ComicInfo: authors go to Writer, categories to Genre,
+// the published date to Year/Month/Day and the language is normalized (see NormalizeLanguageCode).
+// Blank authors and categories are skipped. The returned ComicInfo is validated.
+func FromOPDSEntry(entry OPDSEntry) (ci ComicInfov2, err error) {
+	ci.Title = strings.TrimSpace(entry.Title)
+	ci.Summary = strings.TrimSpace(entry.Summary)
+	ci.Publisher = strings.TrimSpace(entry.Publisher)
+	ci.Writer = FormatCommaField(entry.Authors)
+	ci.Genre = FormatCommaField(entry.Categories)
+	ci.Web = strings.Join(strings.Fields(strings.Join(entry.Web, " ")), " ")
+	if !entry.Published.IsZero() {
+		ci.SetDate(entry.Published)
+	}
+	if lang := strings.TrimSpace(entry.Language); lang != "" {
+		if err = ci.SetLanguageCode(lang); err != nil {
+			return ComicInfov2{}, fmt.Errorf("failed to convert OPDS language: %w", err)
+		}
+	}
+	if err = ci.Validate(); err != nil {
+		return ComicInfov2{}, fmt.Errorf("validation failed: %w", err)
+	}
+	return
+}
@@ -0,0 +1,277 @@
+package comicinfo
+
+import (
+	"time"
+)
+
+// ComicInfoOption is a functional option applied to a ComicInfov2 by NewComicInfov2().
+type ComicInfoOption func(*ComicInfov2)
+
+// WithTitle sets the Title field. Title of the book.
+func WithTitle(title string) ComicInfoOption {
+	return func(ci *ComicInfov2) {
+		ci.Title = title
+	}
+}
+
+// WithSeries sets the Series field. Title of the series the book is part of.
+func WithSeries(series string) ComicInfoOption {
+	return func(ci *ComicInfov2) {
+		ci.Series = series
+	}
+}
+
+// WithNumber sets the Number field. Number of the book in the series.
+func WithNumber(number int) ComicInfoOption {
+	return func(ci *ComicInfov2) {
+		ci.Number = number
+	}
+}
+
+// WithCount sets the Count field. Total number of books in the series.
+func WithCount(count int) ComicInfoOption {
+	return func(ci *ComicInfov2) {
+		ci.Count = count
+	}
+}
+
+// WithVolume sets the Volume field. Volume containing the book.
+func WithVolume(volume int) ComicInfoOption {
+	return func(ci *ComicInfov2) {
+		ci.Volume = volume
+	}
+}
+
+// WithAlternateSeries sets the AlternateSeries field. Alternate series the book is part of.
+func WithAlternateSeries(series string) ComicInfoOption {
+	return func(ci *ComicInfov2) {
+		ci.AlternateSeries = series
+	}
+}
+
+// WithAlternateNumber sets the AlternateNumber field. Number of the book in the alternate series.
+func WithAlternateNumber(number int) ComicInfoOption {
+	return func(ci *ComicInfov2) {
+		ci.AlternateNumber = number
+	}
+}
+
+// WithAlternateCount sets the AlternateCount field. Total number of books in the alternate series.
+func WithAlternateCount(count int) ComicInfoOption {
+	return func(ci *ComicInfov2) {
+		ci.AlternateCount = count
+	}
+}
+
+// WithSummary sets the Summary field. Description or summary of the book.
+func WithSummary(summary string) ComicInfoOption {
+	return func(ci *ComicInfov2) {
+		ci.Summary = summary
+	}
+}
+
+// WithNotes sets the Notes field. Free text notes, usually about the application that created the file.
+func WithNotes(notes string) ComicInfoOption {
+	return func(ci *ComicInfov2) {
+		ci.Notes = notes
+	}
+}
+
+// WithDate sets the Year, Month and Day fields from the given release date.
+func WithDate(t time.Time) ComicInfoOption {
+	return func(ci *ComicInfov2) {
+		ci.Year = t.Year()
+		ci.Month = int(t.Month())
+		ci.Day = t.Day()
+	}
+}
+
+// WithWriter sets the comma separated Writer field from the given values.
+func WithWriter(values ...string) ComicInfoOption {
+	return func(ci *ComicInfov2) {
+		ci.Writer = FormatCommaField(values)
+	}
+}
+
+// WithPenciller sets the comma separated Penciller field from the given values.
+func WithPenciller(values ...string) ComicInfoOption {
+	return func(ci *ComicInfov2) {
+		ci.Penciller = FormatCommaField(values)
+	}
+}
+
+// WithInker sets the comma separated Inker field from the given values.
+func WithInker(values ...string) ComicInfoOption {
+	return func(ci *ComicInfov2) {
+		ci.Inker = FormatCommaField(values)
+	}
+}
+
+// WithColorist sets the comma separated Colorist field from the given values.
+func WithColorist(values ...string) ComicInfoOption {
+	return func(ci *ComicInfov2) {
+		ci.Colorist = FormatCommaField(values)
+	}
+}
+
+// WithLetterer sets the comma separated Letterer field from the given values.
+func WithLetterer(values ...string) ComicInfoOption {
+	return func(ci *ComicInfov2) {
+		ci.Letterer = FormatCommaField(values)
+	}
+}
+
+// WithCoverArtist sets the comma separated CoverArtist field from the given values.
+func WithCoverArtist(values ...string) ComicInfoOption {
+	return func(ci *ComicInfov2) {
+		ci.CoverArtist = FormatCommaField(values)
+	}
+}
+
+// WithEditor sets the comma separated Editor field from the given values.
+func WithEditor(values ...string) ComicInfoOption {
+	return func(ci *ComicInfov2) {
+		ci.Editor = FormatCommaField(values)
+	}
+}
+
+// WithPublisher sets the Publisher field. Publisher of the book.
+func WithPublisher(publisher string) ComicInfoOption {
+	return func(ci *ComicInfov2) {
+		ci.Publisher = publisher
+	}
+}
+
+// WithImprint sets the Imprint field. Imprint of the publisher.
+func WithImprint(imprint string) ComicInfoOption {
+	return func(ci *ComicInfov2) {
+		ci.Imprint = imprint
+	}
+}
+
+// WithGenre sets the comma separated Genre field from the given values.
+func WithGenre(values ...string) ComicInfoOption {
+	return func(ci *ComicInfov2) {
+		ci.Genre = FormatCommaField(values)
+	}
+}
+
+// WithWeb sets the Web field. Reference URL(s) of the book, space separated.
+func WithWeb(web string) ComicInfoOption {
+	return func(ci *ComicInfov2) {
+		ci.Web = web
+	}
+}
+
+// WithPageCount sets the PageCount field. Number of pages in the book.
+func WithPageCount(count int) ComicInfoOption {
+	return func(ci *ComicInfov2) {
+		ci.PageCount = count
+	}
+}
+
+// WithLanguageISO sets the LanguageISO field. ISO code of the language the book is written in.
+func WithLanguageISO(code string) ComicInfoOption {
+	return func(ci *ComicInfov2) {
+		ci.LanguageISO = code
+	}
+}
+
+// WithFormat sets the Format field. Publication format of the book, eg. "Web" or "TBP".
+func WithFormat(format string) ComicInfoOption {
+	return func(ci *ComicInfov2) {
+		ci.Format = format
+	}
+}
+
+// WithBlackAndWhite sets the BlackAndWhite field. Whether the book is in black and white.
+func WithBlackAndWhite(bw YesNo) ComicInfoOption {
+	return func(ci *ComicInfov2) {
+		ci.BlackAndWhite = bw
+	}
+}
+
+// WithManga sets the Manga field. Whether the book is a manga.
+func WithManga(manga Manga) ComicInfoOption {
+	return func(ci *ComicInfov2) {
+		ci.Manga = manga
+	}
+}
+
+// WithCharacters sets the comma separated Characters field from the given values.
+func WithCharacters(values ...string) ComicInfoOption {
+	return func(ci *ComicInfov2) {
+		ci.Characters = FormatCommaField(values)
+	}
+}
+
+// WithTeams sets the comma separated Teams field from the given values.
+func WithTeams(values ...string) ComicInfoOption {
+	return func(ci *ComicInfov2) {
+		ci.Teams = FormatCommaField(values)
+	}
+}
+
+// WithLocations sets the comma separated Locations field from the given values.
+func WithLocations(values ...string) ComicInfoOption {
+	return func(ci *ComicInfov2) {
+		ci.Locations = FormatCommaField(values)
+	}
+}
+
+// WithScanInformation sets the ScanInformation field. Information about who scanned the book.
+func WithScanInformation(info string) ComicInfoOption {
+	return func(ci *ComicInfov2) {
+		ci.ScanInformation = info
+	}
+}
+
+// WithStoryArc sets the StoryArc field. Story arc(s) the book belongs to.
+func WithStoryArc(arc string) ComicInfoOption {
+	return func(ci *ComicInfov2) {
+		ci.StoryArc = arc
+	}
+}
+
+// WithSeriesGroup sets the comma separated SeriesGroup field from the given values.
+func WithSeriesGroup(values ...string) ComicInfoOption {
+	return func(ci *ComicInfov2) {
+		ci.SeriesGroup = FormatCommaField(values)
+	}
+}
+
+// WithAgeRating sets the AgeRating field. Age rating of the book.
+func WithAgeRating(rating AgeRating) ComicInfoOption {
+	return func(ci *ComicInfov2) {
+		ci.AgeRating = rating
+	}
+}
+
+// WithPages sets the Pages field.
+func WithPages(pages ...PageV2) ComicInfoOption {
+	return func(ci *ComicInfov2) {
+		ci.Pages = PagesV2{Pages: pages}
+	}
+}
+
+// WithCommunityRating sets the CommunityRating field.
+func WithCommunityRating(rating float64) ComicInfoOption {
+	return func(ci *ComicInfov2) {
+		cr := CommunityRating(rating)
+		ci.CommunityRating = &cr
+	}
+}
+
+// WithMainCharacterOrTeam sets the MainCharacterOrTeam field. Main character or team of the book.
+func WithMainCharacterOrTeam(name string) ComicInfoOption {
+	return func(ci *ComicInfov2) {
+		ci.MainCharacterOrTeam = name
+	}
+}
+
+// WithReview sets the Review field. Review of the book.
+func WithReview(review string) ComicInfoOption {
+	return func(ci *ComicInfov2) {
+		ci.Review = review
+	}
+}
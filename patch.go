@@ -0,0 +1,40 @@
+package comicinfo
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// FieldChange represents the modification of a single ComicInfo field, identified by its XML element name (eg. "Title").
+type FieldChange struct {
+	Field string
+	Old   interface{}
+	New   interface{}
+}
+
+// Patch applies changes to a copy of base and returns it. Each change sets its field to its New value, Old is informational only.
+// Values are converted like with ComicInfov2.Set, eg. "5" can be used for Number.
+// An error is returned if a field name is unknown or if a value can not be assigned to its field.
+func Patch(base ComicInfov2, changes []FieldChange) (ComicInfov2, error) {
+	if err := applyChanges(reflect.ValueOf(&base).Elem(), changes); err != nil {
+		return ComicInfov2{}, err
+	}
+	return base, nil
+}
+
+// PatchV21 is the ComicInfov21 counterpart of Patch.
+func PatchV21(base ComicInfov21, changes []FieldChange) (ComicInfov21, error) {
+	if err := applyChanges(reflect.ValueOf(&base).Elem(), changes); err != nil {
+		return ComicInfov21{}, err
+	}
+	return base, nil
+}
+
+func applyChanges(v reflect.Value, changes []FieldChange) error {
+	for i, change := range changes {
+		if err := setFieldByXMLName(v, change.Field, change.New); err != nil {
+			return fmt.Errorf("change #%d: %w", i, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package comicinfo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPatchMatchesSet(t *testing.T) {
+	changes := []FieldChange{
+		{Field: "Number", New: "5"},
+		{Field: "Series", New: 42},
+		{Field: "CommunityRating", New: 3.5},
+		{Field: "AgeRating", New: "Teen"},
+		{Field: "Title", New: nil},
+	}
+	base := newTestComicInfov2()
+	patched, err := Patch(base, changes)
+	if err != nil {
+		t.Fatalf("Patch failed: %s", err)
+	}
+	expected := base
+	for _, change := range changes {
+		if err = expected.Set(change.Field, change.New); err != nil {
+			t.Fatalf("Set(%q) failed: %s", change.Field, err)
+		}
+	}
+	if !reflect.DeepEqual(patched, expected) {
+		t.Errorf("Patch and Set results differ:\n%+v\n---\n%+v", patched, expected)
+	}
+	if base.Number != 12 || base.Title == "" {
+		t.Error("Patch must not modify base")
+	}
+}
+
+func TestPatchV21(t *testing.T) {
+	patched, err := PatchV21(newTestComicInfov21(), []FieldChange{{Field: "Number", New: "7"}, {Field: "Translator", New: "Ada"}})
+	if err != nil {
+		t.Fatalf("PatchV21 failed: %s", err)
+	}
+	if patched.Number != 7 || patched.Translator != "Ada" {
+		t.Errorf("unexpected result: Number %d, Translator %q", patched.Number, patched.Translator)
+	}
+}
+
+func TestPatchErrors(t *testing.T) {
+	for _, change := range []FieldChange{
+		{Field: "Unknown", New: "value"},
+		{Field: "Number", New: "five"},
+		{Field: "Pages", New: "page1.jpg"},
+	} {
+		if _, err := Patch(newTestComicInfov2(), []FieldChange{change}); err == nil {
+			t.Errorf("Patch(%q, %v): expected an error", change.Field, change.New)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package comicinfo
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// pooledEncoder is an XML encoder bound to its own buffer. As an xml.Encoder output can not be changed,
+// the document is encoded into the buffer and then copied to the final writer. Once reused, the encoder
+// separates the new root element from the previous one with a newline which must be dropped.
+type pooledEncoder struct {
+	buffer  *bytes.Buffer
+	encoder *xml.Encoder
+}
+
+var encoderPool = sync.Pool{
+	New: func() interface{} {
+		pe := &pooledEncoder{
+			buffer: new(bytes.Buffer),
+		}
+		pe.encoder = xml.NewEncoder(pe.buffer)
+		pe.encoder.Indent("", "\t")
+		return pe
+	},
+}
+
+// EncodePooled produces the same output as ci.Encode(output) but recycles the XML encoders and their buffers
+// between calls. It is intended for high throughput batch encoding and is safe for concurrent use.
+func EncodePooled(output io.Writer, ci ComicInfov2) (err error) {
+	if output == nil {
+		return errors.New("output cannot be nil")
+	}
+	// Fill in the page count if the user did not
+	if ci.PageCount == 0 && len(ci.Pages.Pages) > 0 {
+		ci.SyncPageCount()
+	}
+	// Validate some fields before encoding
+	if err = ci.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+	// Encode within a pooled buffer
+	pe := encoderPool.Get().(*pooledEncoder)
+	pe.buffer.Reset()
+	if err = pe.encoder.Encode(ci); err != nil {
+		// do not put back the encoder as its internal state might be inconsistent
+		return fmt.Errorf("failed to encode ComicInfo v2 XML: %w", err)
+	}
+	defer encoderPool.Put(pe)
+	// Write the result
+	if _, err = output.Write([]byte(xml.Header)); err != nil {
+		return fmt.Errorf("failed to write XML header: %w", err)
+	}
+	if _, err = output.Write(bytes.TrimPrefix(pe.buffer.Bytes(), []byte("\n"))); err != nil {
+		return fmt.Errorf("failed to write XML: %w", err)
+	}
+	return
+}
@@ -0,0 +1,45 @@
+package comicinfo
+
+import (
+	"bytes"
+	"io"
+	"testing"
+)
+
+func TestEncodePooledMatchesEncode(t *testing.T) {
+	ci := newTestComicInfov2()
+	var expected bytes.Buffer
+	if err := ci.Encode(&expected); err != nil {
+		t.Fatalf("Encode failed: %s", err)
+	}
+	// Encode several times to make sure reused encoders produce the same output
+	for i := 0; i < 3; i++ {
+		var pooled bytes.Buffer
+		if err := EncodePooled(&pooled, ci); err != nil {
+			t.Fatalf("EncodePooled failed on call %d: %s", i, err)
+		}
+		if !bytes.Equal(expected.Bytes(), pooled.Bytes()) {
+			t.Fatalf("EncodePooled output differs from Encode on call %d:\n%s\n---\n%s", i, expected.String(), pooled.String())
+		}
+	}
+}
+
+func BenchmarkEncode(b *testing.B) {
+	ci := newTestComicInfov2()
+	b.ReportAllocs()
+	for b.Loop() {
+		if err := ci.Encode(io.Discard); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkEncodePooled(b *testing.B) {
+	ci := newTestComicInfov2()
+	b.ReportAllocs()
+	for b.Loop() {
+		if err := EncodePooled(io.Discard, ci); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
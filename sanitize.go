@@ -0,0 +1,103 @@
+package comicinfo
+
+import (
+	"reflect"
+	"strings"
+	"unicode"
+)
+
+// StripControlCharacters removes from every string field the characters which are invalid in XML 1.0 (control characters
+// except tab, newline and carriage return, U+FFFE and U+FFFF) and the invisible zero width space, word joiner and byte
+// order mark. Joiners (ZWJ, ZWNJ) are kept as they are required by some scripts and emoji sequences. Page keys are left
+// untouched as they must match the archive entry names. Scraped metadata often contains some of these characters,
+// this is meant to be called before Encode().
+func (ci *ComicInfov2) StripControlCharacters() {
+	mapStringFields(reflect.ValueOf(ci).Elem(), stripControlCharacters)
+}
+
+// StripControlCharacters is the ComicInfov21 counterpart of ComicInfov2.StripControlCharacters.
+func (ci *ComicInfov21) StripControlCharacters() {
+	mapStringFields(reflect.ValueOf(ci).Elem(), stripControlCharacters)
+}
+
+// Sanitize normalizes the fields before storage, validation or encoding: control characters are stripped
+// (see StripControlCharacters), comma separated fields are trimmed (see TrimCommaFields), spaces within the Web URLs
+// are encoded (see NormalizeWebField), LanguageISO is canonicalized when valid (see NormalizeLanguageCode) and
+// PageCount is synced with the pages list when there is one.
+func (ci *ComicInfov2) Sanitize() {
+	ci.StripControlCharacters()
+	ci.TrimCommaFields()
+	ci.Web = NormalizeWebField(ci.Web)
+	if normalized, err := NormalizeLanguageCode(ci.LanguageISO); err == nil {
+		ci.LanguageISO = normalized
+	}
+	if len(ci.Pages.Pages) > 0 {
+		ci.SyncPageCount()
+	}
+}
+
+// Sanitize is the ComicInfov21 counterpart of ComicInfov2.Sanitize.
+func (ci *ComicInfov21) Sanitize() {
+	ci.StripControlCharacters()
+	ci.TrimCommaFields()
+	ci.Web = NormalizeWebField(ci.Web)
+	if normalized, err := NormalizeLanguageCode(ci.LanguageISO); err == nil {
+		ci.LanguageISO = normalized
+	}
+	if len(ci.Pages.Pages) > 0 {
+		ci.SyncPageCount()
+	}
+}
+
+func stripControlCharacters(s string) string {
+	return strings.Map(func(r rune) rune {
+		if !isXMLCharacter(r) || isInvisibleCharacter(r) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// isXMLCharacter returns true if r matches the Char production of XML 1.0.
+func isXMLCharacter(r rune) bool {
+	return r == '\t' || r == '\n' || r == '\r' ||
+		r >= 0x20 && r <= 0xD7FF ||
+		r >= 0xE000 && r <= 0xFFFD ||
+		r >= 0x10000 && r <= unicode.MaxRune
+}
+
+func isInvisibleCharacter(r rune) bool {
+	switch r {
+	case '\u200B', // zero width space
+		'\u2060', // word joiner
+		'\uFEFF': // byte order mark / zero width no-break space
+		return true
+	default:
+		return false
+	}
+}
+
+// mapStringFields replaces every string of v (except page keys), walking through structs, slices and pointers, with its mapping by fn.
+func mapStringFields(v reflect.Value, fn func(string) string) {
+	switch v.Kind() {
+	case reflect.String:
+		if v.CanSet() {
+			v.SetString(fn(v.String()))
+		}
+	case reflect.Struct:
+		for i := range v.NumField() {
+			if v.Type() == reflect.TypeOf(PageV2{}) && v.Type().Field(i).Name == "Key" {
+				continue // must keep matching the archive entry name
+			}
+			mapStringFields(v.Field(i), fn)
+		}
+	case reflect.Slice:
+		for i := range v.Len() {
+			mapStringFields(v.Index(i), fn)
+		}
+	case reflect.Pointer:
+		if !v.IsNil() {
+			mapStringFields(v.Elem(), fn)
+		}
+	}
+}
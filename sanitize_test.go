@@ -0,0 +1,45 @@
+package comicinfo
+
+import (
+	"testing"
+)
+
+func TestStripControlCharacters(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"Plain title", "Plain title"},
+		{"Line\tone\r\nLine two", "Line\tone\r\nLine two"},
+		{"Bell\x07 and\x00 null", "Bell and null"},
+		{"\uFEFFByte order mark", "Byte order mark"},
+		{"Zero\u200Bwidth\u2060space", "Zerowidthspace"},
+		{"Non\uFFFEcharacters\uFFFF", "Noncharacters"},
+		{"Next\u0085line", "Next\u0085line"},   // C1 controls are valid XML characters
+		{"می\u200Cخواهم", "می\u200Cخواهم"},     // ZWNJ is required in Persian
+		{"👨\u200D👩\u200D👧", "👨\u200D👩\u200D👧"}, // ZWJ emoji sequence
+		{"Astral 𝄞 plane", "Astral 𝄞 plane"},
+	}
+	for _, test := range tests {
+		if got := stripControlCharacters(test.input); got != test.expected {
+			t.Errorf("stripControlCharacters(%q): expected %q, got %q", test.input, test.expected, got)
+		}
+	}
+}
+
+func TestStripControlCharactersFields(t *testing.T) {
+	ci := newTestComicInfov2()
+	ci.Title = "\uFEFFTitle\x1b"
+	ci.Pages.Pages[0].Key = "page\u200B01.jpg"
+	ci.Pages.Pages[0].Bookmark = "Chapter\x001"
+	ci.StripControlCharacters()
+	if ci.Title != "Title" {
+		t.Errorf("Title: expected %q, got %q", "Title", ci.Title)
+	}
+	if ci.Pages.Pages[0].Bookmark != "Chapter1" {
+		t.Errorf("Bookmark: expected %q, got %q", "Chapter1", ci.Pages.Pages[0].Bookmark)
+	}
+	if ci.Pages.Pages[0].Key != "page\u200B01.jpg" {
+		t.Errorf("Key: expected to be left untouched, got %q", ci.Pages.Pages[0].Key)
+	}
+}
@@ -0,0 +1,59 @@
+package comicinfo
+
+import (
+	"slices"
+	"strings"
+)
+
+// SearchIndex is an in memory inverted index of comics by character, genre and creator. Lookups are case-insensitive.
+// The zero value is ready to use. A SearchIndex is not safe for concurrent use.
+type SearchIndex struct {
+	characters map[string][]string
+	genres     map[string][]string
+	creators   map[CreatorRole]map[string][]string
+}
+
+// AddComicInfoV2 indexes ci under id. Adding the same id again indexes the new values without removing the previous ones.
+func (si *SearchIndex) AddComicInfoV2(id string, ci ComicInfov2) {
+	if si.characters == nil {
+		si.characters = make(map[string][]string)
+		si.genres = make(map[string][]string)
+		si.creators = make(map[CreatorRole]map[string][]string, len(creatorRolesV2))
+	}
+	indexCommaField(si.characters, ci.Characters, id)
+	indexCommaField(si.genres, ci.Genre, id)
+	for _, role := range creatorRolesV2 {
+		if si.creators[role] == nil {
+			si.creators[role] = make(map[string][]string)
+		}
+		indexCommaField(si.creators[role], *ci.creatorField(role), id)
+	}
+}
+
+// FindByCharacter returns the ids of the comics featuring the character, in the order they were added.
+func (si SearchIndex) FindByCharacter(name string) []string {
+	return slices.Clone(si.characters[searchKey(name)])
+}
+
+// FindByGenre returns the ids of the comics of the genre, in the order they were added.
+func (si SearchIndex) FindByGenre(genre string) []string {
+	return slices.Clone(si.genres[searchKey(genre)])
+}
+
+// FindByCreator returns the ids of the comics where name is credited with role, in the order they were added.
+func (si SearchIndex) FindByCreator(role CreatorRole, name string) []string {
+	return slices.Clone(si.creators[role][searchKey(name)])
+}
+
+func indexCommaField(index map[string][]string, field, id string) {
+	for _, value := range ParseCommaField(field) {
+		key := searchKey(value)
+		if !slices.Contains(index[key], id) {
+			index[key] = append(index[key], id)
+		}
+	}
+}
+
+func searchKey(value string) string {
+	return strings.ToLower(strings.TrimSpace(value))
+}
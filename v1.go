@@ -6,13 +6,17 @@ import (
 	"fmt"
 	"io"
 	"net/url"
+	"reflect"
 	"strings"
+	"sync"
+	"time"
 
 	"golang.org/x/text/language"
 )
 
 const (
 	v1SchemaLocationURL = "https://github.com/anansi-project/comicinfo/raw/refs/heads/main/schema/v1.0/ComicInfo.xsd"
+	v1DocumentationURL  = "https://github.com/anansi-project/comicinfo/tree/main/schema/v1.0"
 )
 
 // ComicInfoComicInfov1 represents the structure of a version 1 ComicInfo.xml file.
@@ -40,7 +44,7 @@ type ComicInfov1 struct {
 	Imprint         string `xml:"Imprint,omitempty"`         // An imprint is a group of publications under the umbrella of a larger imprint or a Publisher. For example, Vertigo is an Imprint of DC Comics.
 	Genre           string `xml:"Genre,omitempty"`           // Genre of the book or series. For example, Science-Fiction or Shonen. It is accepted that multiple values are comma separated.
 	Web             string `xml:"Web,omitempty"`             // A URL pointing to a reference website for the book. It is accepted that multiple values are space separated (as spaces in URL will be encoded as %20).
-	PageCount       int    `xml:"PageCount,omitempty"`       // The number of pages in the book.
+	PageCount       int    `xml:"PageCount,omitempty"`       // The number of pages in the book. This is an informational field which may disagree with the actual Pages list, see Pages.Count().
 	Language        string `xml:"LanguageISO,omitempty"`     // ISO code of the language the book is written in. You can use "golang.org/x/text/language" to get valid codes, eg language.English.String()
 	Format          string `xml:"format,omitempty"`          // The original publication's binding format for scanned physical books or presentation format for digital sources. "TBP", "HC", "Web", "Digital" are common designators.
 	BlackAndWhite   YesNo  `xml:"BlackAndWhite,omitempty"`   // Whether the book is in black and white.
@@ -48,6 +52,14 @@ type ComicInfov1 struct {
 	Pages           Pages  `xml:"Pages,omitempty"`           // Pages of the comic book. Each page should have an Image element with a file path to the image.
 }
 
+// NewComicInfov1 returns a v1 ComicInfo with the enumerated fields explicitly set to their unknown value.
+func NewComicInfov1() ComicInfov1 {
+	return ComicInfov1{
+		BlackAndWhite: Unknown,
+		Manga:         MangaUnknown,
+	}
+}
+
 // Encode will produce a ComicInfo v2 XML content. It will validate the ComicInfo struct before encoding it into XML format.
 func (ci ComicInfov1) Encode(output io.Writer) (err error) {
 	if output == nil {
@@ -73,6 +85,7 @@ func (ci ComicInfov1) Encode(output io.Writer) (err error) {
 // MarshalXML implements the xml.Marshaler interface to automatically add schema attributes.
 // User should use Encode() instead of this method directly. This method is used internally by Encode().
 func (ci ComicInfov1) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name.Local = "ComicInfo" // Correct name for root name
 	type Mask ComicInfov1
 	type attr struct {
 		Mask
@@ -86,35 +99,205 @@ func (ci ComicInfov1) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	}, start)
 }
 
+// SpecDocumentationURL returns the link to the documentation of the v1 schema, for example to refer users to it.
+func (ci ComicInfov1) SpecDocumentationURL() string {
+	return v1DocumentationURL
+}
+
+// IsEmpty returns true if no field has been set. An empty (but non nil) pages list is considered empty too.
+func (ci ComicInfov1) IsEmpty() bool {
+	if len(ci.Pages) > 0 {
+		return false
+	}
+	ci.Pages = nil
+	return reflect.ValueOf(ci).IsZero()
+}
+
+// SetBlackAndWhite sets the BlackAndWhite field to Yes or No.
+func (ci *ComicInfov1) SetBlackAndWhite(bw bool) {
+	if bw {
+		ci.BlackAndWhite = Yes
+	} else {
+		ci.BlackAndWhite = No
+	}
+}
+
+// SetManga sets the Manga field: MangaYesAndRightToLeft if the book is a manga read from right to left,
+// MangaYes if it is a manga read from left to right and MangaNo otherwise.
+func (ci *ComicInfov1) SetManga(isManga bool, rightToLeft bool) {
+	switch {
+	case isManga && rightToLeft:
+		ci.Manga = MangaYesAndRightToLeft
+	case isManga:
+		ci.Manga = MangaYes
+	default:
+		ci.Manga = MangaNo
+	}
+}
+
+// DateString returns the release date as an ISO 8601 string: "YYYY-MM" or "YYYY" (v1 has no Day field) depending on which fields are set.
+// It returns an empty string if Year is not set.
+func (ci ComicInfov1) DateString() string {
+	return formatDate(ci.Year, ci.Month, 0)
+}
+
+// GetPublishDate returns the release date at UTC midnight or nil if Year is not set. Day (not available in v1) is always 1 and Month defaults to 1 if not set.
+func (ci ComicInfov1) GetPublishDate() *time.Time {
+	return publishDate(ci.Year, ci.Month, 0)
+}
+
+// WebURLCount returns the number of space separated URLs within the Web field.
+func (ci ComicInfov1) WebURLCount() int {
+	return webURLCount(ci.Web)
+}
+
+// FirstWebURL returns the first URL of the Web field, usually the primary reference website of the book.
+// An error is returned if the field is empty or if the first URL can not be parsed.
+func (ci ComicInfov1) FirstWebURL() (*url.URL, error) {
+	return firstWebURL(ci.Web)
+}
+
+// SetWebURLs replaces the Web field with the given URLs, nil ones being skipped.
+func (ci *ComicInfov1) SetWebURLs(urls []*url.URL) {
+	ci.Web = formatWebURLs(urls)
+}
+
+// SetLanguageFromTag sets the Language field from a language tag, eg. language.BrazilianPortuguese.
+func (ci *ComicInfov1) SetLanguageFromTag(tag language.Tag) {
+	ci.Language = tag.String()
+}
+
+// SetLanguageCode sets the Language field to the canonical form of code (see NormalizeLanguageCode).
+// The field is left untouched if code is not a valid BCP 47 tag.
+func (ci *ComicInfov1) SetLanguageCode(code string) error {
+	normalized, err := NormalizeLanguageCode(code)
+	if err != nil {
+		return err
+	}
+	ci.Language = normalized
+	return nil
+}
+
+// GetEditors returns the values of the comma separated Editor field.
+func (ci ComicInfov1) GetEditors() []string {
+	return ParseCommaField(ci.Editor)
+}
+
+// SetEditors replaces the Editor field with the given values.
+func (ci *ComicInfov1) SetEditors(editors []string) {
+	ci.Editor = FormatCommaField(editors)
+}
+
+// AddEditor adds an editor to the Editor field if not already present (case-insensitive).
+func (ci *ComicInfov1) AddEditor(editor string) {
+	addCommaValue(&ci.Editor, editor)
+}
+
+// RemoveEditor removes an editor from the Editor field (case-insensitive). It returns false if it was not found.
+func (ci *ComicInfov1) RemoveEditor(editor string) bool {
+	return removeCommaValue(&ci.Editor, editor)
+}
+
+// HasEditor returns true if an editor is present in the Editor field (case-insensitive).
+func (ci ComicInfov1) HasEditor(editor string) bool {
+	return hasCommaValue(ci.Editor, editor)
+}
+
 // Validate checks if some of the fields with particular constraints are valid. It returns an error if any field fails validation.
-func (ci ComicInfov1) Validate() (err error) {
+// All the failures are joined within the returned error, use ValidateAll() to get them individually.
+func (ci ComicInfov1) Validate() error {
+	return errors.Join(ci.ValidateAll()...)
+}
+
+// ValidateAll runs every validation check and returns all the failures. It returns nil if no check failed.
+func (ci ComicInfov1) ValidateAll() (errs []error) {
+	var err error
 	// URL(s)
-	for index, URL := range strings.Split(ci.Web, " ") {
-		if _, err = url.Parse(URL); err != nil {
-			return fmt.Errorf("failed to validate URL #%d: %w", index, err)
-		}
-	}
+	errs = append(errs, validateWebURLs(ci.Web)...)
 	// Language
 	if ci.Language != "" {
-		if _, err = language.Parse(ci.Language); err != nil {
-			return fmt.Errorf("failed to validate Language: %s", ci.Language)
+		if err = ValidateLanguageCode(ci.Language); err != nil {
+			errs = append(errs, fmt.Errorf("failed to validate Language: %w", err))
 		}
 	}
+	// Numbering
+	errs = append(errs, validateNumbering(ci.Number, ci.Count, ci.Volume, ci.AlternateNumber, ci.AlternateCount)...)
+	if err = validateAlternateSeries(ci.AlternateSeries, ci.AlternateNumber, ci.AlternateCount); err != nil {
+		errs = append(errs, err)
+	}
+	// Creators
+	if err = ci.ValidateCreatorFields(); err != nil {
+		errs = append(errs, fmt.Errorf("failed to validate creators: %w", err))
+	}
+	// Date
+	if err = validatePartialDate(ci.Year, ci.Month, 0); err != nil {
+		errs = append(errs, fmt.Errorf("failed to validate date: %w", err))
+	}
+	if ci.Month < 0 || ci.Month > 12 {
+		errs = append(errs, fmt.Errorf("failed to validate Month: %d is not within 1-12", ci.Month))
+	}
 	// BlackAndWhite
 	if !ci.BlackAndWhite.IsValid() {
-		return fmt.Errorf("failed to validate BlackAndWhite: unknown value %q", ci.BlackAndWhite)
+		errs = append(errs, fmt.Errorf("failed to validate BlackAndWhite: unknown value %q", ci.BlackAndWhite))
 	}
 	// Manga
 	if !ci.Manga.IsValid() {
-		return fmt.Errorf("failed to validate Manga: unknown value %q", ci.Manga)
+		errs = append(errs, fmt.Errorf("failed to validate Manga: unknown value %q", ci.Manga))
 	}
 	// Pages
 	if err = ci.Pages.Validate(); err != nil {
-		return fmt.Errorf("failed to validate Pages: %w", err)
+		errs = append(errs, fmt.Errorf("failed to validate Pages: %w", err))
 	}
 	return
 }
 
+// ToV21 converts the ComicInfo to the v2.1 DRAFT format, skipping the v2 intermediate representation.
+// Every v1 field has a v2.1 counterpart, the returned warnings list the semantic differences met during the conversion.
+func (ci ComicInfov1) ToV21() (upgraded ComicInfov21, warnings []string) {
+	upgraded = ComicInfov21{
+		Title:           ci.Title,
+		Series:          ci.Series,
+		Number:          ci.Number,
+		Count:           ci.Count,
+		Volume:          ci.Volume,
+		AlternateSeries: ci.AlternateSeries,
+		AlternateNumber: ci.AlternateNumber,
+		AlternateCount:  ci.AlternateCount,
+		Summary:         ci.Summary,
+		Notes:           ci.Notes,
+		Year:            ci.Year,
+		Month:           ci.Month,
+		Writer:          ci.Writer,
+		Penciller:       ci.Penciller,
+		Inker:           ci.Inker,
+		Colorist:        ci.Colorist,
+		Letterer:        ci.Letterer,
+		CoverArtist:     ci.CoverArtist,
+		Editor:          ci.Editor,
+		Publisher:       ci.Publisher,
+		Imprint:         ci.Imprint,
+		Genre:           ci.Genre,
+		Web:             ci.Web,
+		PageCount:       ci.PageCount,
+		LanguageISO:     ci.Language,
+		Format:          ci.Format,
+		BlackAndWhite:   ci.BlackAndWhite,
+		Manga:           ci.Manga,
+	}
+	if len(ci.Pages) > 0 {
+		upgraded.Pages = ci.Pages.ToV2()
+		warnings = append(warnings, "Pages: v1 pages have no Bookmark attribute, v2.1 pages Bookmark are left empty")
+	}
+	if ci.Year != 0 {
+		warnings = append(warnings, "Day: v1 has no Day field, the release date is only precise to the month")
+	}
+	if err := upgraded.Validate(); err != nil {
+		warnings = append(warnings, fmt.Sprintf("the upgraded ComicInfo is not valid: %s", err))
+	}
+	return
+}
+
+// YesNo is the type of the BlackAndWhite field. Its typed constants are the only values accepted by the schemas.
 type YesNo string
 
 const (
@@ -132,6 +315,37 @@ func (yn YesNo) IsValid() bool {
 	}
 }
 
+// MarshalXML implements the xml.Marshaler interface, writing the canonical form of the value (see UnmarshalXML).
+func (yn YesNo) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(string(parseYesNo(string(yn))), start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface, normalizing the informal variations found in the wild:
+// "yes", "true" and "1" become Yes, "no", "false" and "0" become No and "unknown" becomes Unknown (case-insensitive).
+// Other values are kept as is and will fail IsValid().
+func (yn *YesNo) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var value string
+	if err := d.DecodeElement(&value, &start); err != nil {
+		return err
+	}
+	*yn = parseYesNo(value)
+	return nil
+}
+
+func parseYesNo(value string) YesNo {
+	switch value = strings.TrimSpace(value); strings.ToLower(value) {
+	case "yes", "true", "1":
+		return Yes
+	case "no", "false", "0":
+		return No
+	case "unknown":
+		return Unknown
+	default:
+		return YesNo(value)
+	}
+}
+
+// Manga is the type of the Manga field. Its typed constants are the only values accepted by the schemas.
 type Manga string
 
 const (
@@ -150,6 +364,48 @@ func (m Manga) IsValid() bool {
 	}
 }
 
+// MarshalXML implements the xml.Marshaler interface, writing the canonical form of the value (see UnmarshalXML).
+func (m Manga) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(string(parseManga(string(m))), start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface, normalizing the non standard values found in the wild:
+// "yes" and "true" become MangaYes, "no" and "false" become MangaNo, "rtl" and "right-to-left" become
+// MangaYesAndRightToLeft and "unknown" becomes MangaUnknown (case-insensitive). Other values are kept as is and will fail IsValid().
+func (m *Manga) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var value string
+	if err := d.DecodeElement(&value, &start); err != nil {
+		return err
+	}
+	*m = parseManga(value)
+	return nil
+}
+
+func parseManga(value string) Manga {
+	switch value = strings.TrimSpace(value); strings.ToLower(value) {
+	case "yes", "true":
+		return MangaYes
+	case "no", "false":
+		return MangaNo
+	case "yesandrighttoleft", "rtl", "right-to-left":
+		return MangaYesAndRightToLeft
+	case "unknown":
+		return MangaUnknown
+	default:
+		return Manga(value)
+	}
+}
+
+// IsManga returns true if the book is a manga, whatever its reading direction.
+func (m Manga) IsManga() bool {
+	return m == MangaYes || m == MangaYesAndRightToLeft
+}
+
+// IsRightToLeft returns true if the book must be read from right to left.
+func (m Manga) IsRightToLeft() bool {
+	return m == MangaYesAndRightToLeft
+}
+
 type Pages []Page
 
 func (ps Pages) Validate() (err error) {
@@ -167,6 +423,51 @@ func (ps Pages) Validate() (err error) {
 	return
 }
 
+// ValidatePages checks a standalone pages list, for example before attaching it to a ComicInfov1. See Pages.Validate().
+func ValidatePages(ps Pages) error {
+	return ps.Validate()
+}
+
+// ToV2 converts the pages to the v2 (and v2.1) pages list. The Bookmark of the converted pages is left empty as v1 pages have none.
+func (ps Pages) ToV2() PagesV2 {
+	if ps == nil {
+		return PagesV2{}
+	}
+	converted := PagesV2{Pages: make([]PageV2, len(ps))}
+	for i, p := range ps {
+		converted.Pages[i] = PageV2{
+			Image:       p.Image,
+			Type:        p.Type,
+			DoublePage:  p.DoublePage,
+			ImageSize:   p.ImageSize,
+			Key:         p.Key,
+			ImageWidth:  p.ImageWidth,
+			ImageHeight: p.ImageHeight,
+		}
+	}
+	return converted
+}
+
+// Count returns the number of pages within the list. It may differ from the informational PageCount field.
+func (ps Pages) Count() int {
+	return len(ps)
+}
+
+// ValidateSequential checks that the pages Image indexes form a contiguous sequence starting at 0.
+// This is a stricter check than Validate() as some readers skip or mis-render pages when indexes have gaps.
+func (ps Pages) ValidateSequential() error {
+	indexes := make(map[int]struct{}, len(ps))
+	for _, p := range ps {
+		indexes[p.Image] = struct{}{}
+	}
+	for i := range len(ps) {
+		if _, found := indexes[i]; !found {
+			return fmt.Errorf("page indices are not sequential: missing index %d", i)
+		}
+	}
+	return nil
+}
+
 type Page struct {
 	Image       int      `xml:"Image,attr"`
 	Type        PageType `xml:"Type,attr"`
@@ -177,8 +478,19 @@ type Page struct {
 	ImageHeight int      `xml:"ImageHeight,attr"`
 }
 
+// NewPage returns a page with unknown (-1) image dimensions, which unlike the zero value passes validation.
+func NewPage(imageIndex int, key string, pt PageType) Page {
+	return Page{
+		Image:       imageIndex,
+		Type:        pt,
+		Key:         key,
+		ImageWidth:  -1,
+		ImageHeight: -1,
+	}
+}
+
 func (p *Page) Validate() (err error) {
-	if !p.Type.Valid() {
+	if !p.Type.IsValid() {
 		return fmt.Errorf("invalid page type: %q", p.Type)
 	}
 	if !(p.ImageWidth > 0 || p.ImageWidth == -1) {
@@ -206,12 +518,98 @@ const (
 	PageTypeDeleted       PageType = "Deleted"
 )
 
-func (pt PageType) Valid() bool {
+// IsValid returns true if pt is one of the page types defined by the schemas or has been registered with RegisterCustomPageType.
+func (pt PageType) IsValid() bool {
 	switch pt {
 	case PageTypeFrontCover, PageTypeInnerCover, PageTypeRoundup, PageTypeStory, PageTypeAdvertisement,
 		PageTypeEditorial, PageTypeLetters, PageTypePreview, PageTypeBackCover, PageTypeOther, PageTypeDeleted:
 		return true
 	default:
-		return false
+		return isCustomPageType(pt)
+	}
+}
+
+// Valid returns true if pt is one of the page types defined by the schemas or has been registered with RegisterCustomPageType.
+//
+// Deprecated: use IsValid, named like the other types validity checks.
+func (pt PageType) Valid() bool {
+	return pt.IsValid()
+}
+
+var (
+	customPageTypes   = make(map[PageType]struct{})
+	customPageTypesMu sync.RWMutex
+)
+
+// RegisterCustomPageType makes pt a valid page type for the rest of the process, for example "Spine" or "Pin-up" which are
+// used by some tools. Custom page types are NOT part of the ComicInfo schemas: consumers may reject the files using them.
+// It is safe for concurrent use. Empty values are ignored.
+func RegisterCustomPageType(pt PageType) {
+	if pt == "" {
+		return
 	}
+	customPageTypesMu.Lock()
+	defer customPageTypesMu.Unlock()
+	customPageTypes[pt] = struct{}{}
+}
+
+func isCustomPageType(pt PageType) bool {
+	customPageTypesMu.RLock()
+	defer customPageTypesMu.RUnlock()
+	_, found := customPageTypes[pt]
+	return found
+}
+
+// pageTypeSpellings maps the spellings of page types found in the wild, once lowercased and stripped of separators
+// (see pageTypeKey), to their canonical value. For example "frontcover", "Front Cover" and "front_cover" are all FrontCover.
+var pageTypeSpellings = map[string]PageType{
+	"frontcover":     PageTypeFrontCover,
+	"cover":          PageTypeFrontCover,
+	"innercover":     PageTypeInnerCover,
+	"roundup":        PageTypeRoundup,
+	"story":          PageTypeStory,
+	"advertisement":  PageTypeAdvertisement,
+	"advertisements": PageTypeAdvertisement,
+	"advert":         PageTypeAdvertisement,
+	"ad":             PageTypeAdvertisement,
+	"ads":            PageTypeAdvertisement,
+	"editorial":      PageTypeEditorial,
+	"letters":        PageTypeLetters,
+	"letter":         PageTypeLetters,
+	"preview":        PageTypePreview,
+	"backcover":      PageTypeBackCover,
+	"other":          PageTypeOther,
+	"deleted":        PageTypeDeleted,
+}
+
+func pageTypeKey(value string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '_', '-':
+			return -1
+		default:
+			return r
+		}
+	}, strings.ToLower(strings.TrimSpace(value)))
+}
+
+// NormalizePageType returns the canonical page type for a spelling found in the wild (see pageTypeSpellings).
+// Unknown values are returned trimmed but otherwise unchanged and will fail validation.
+func NormalizePageType(value string) PageType {
+	if pt, found := pageTypeSpellings[pageTypeKey(value)]; found {
+		return pt
+	}
+	return PageType(strings.TrimSpace(value))
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface, writing the canonical form of the page type.
+func (pt PageType) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: string(NormalizePageType(string(pt)))}, nil
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface, normalizing the page type (see NormalizePageType).
+// The Type of a page being an attribute, this is the decoding hook used instead of UnmarshalXML.
+func (pt *PageType) UnmarshalXMLAttr(attr xml.Attr) error {
+	*pt = NormalizePageType(attr.Value)
+	return nil
 }
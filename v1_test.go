@@ -0,0 +1,31 @@
+package comicinfo
+
+import (
+	"testing"
+)
+
+func TestPageTypeValidity(t *testing.T) {
+	custom := PageType("TestSpine")
+	RegisterCustomPageType(custom)
+	tests := []struct {
+		pt    PageType
+		valid bool
+	}{
+		{PageTypeFrontCover, true},
+		{PageTypeStory, true},
+		{PageTypeDeleted, true},
+		{custom, true},
+		{"", false},
+		{"frontcover", false},
+		{"Unregistered", false},
+	}
+	for _, test := range tests {
+		if got := test.pt.IsValid(); got != test.valid {
+			t.Errorf("PageType(%q).IsValid(): expected %t, got %t", test.pt, test.valid, got)
+		}
+		// Valid is the deprecated name of IsValid and must be kept for compatibility
+		if got := test.pt.Valid(); got != test.valid {
+			t.Errorf("PageType(%q).Valid(): expected %t, got %t", test.pt, test.valid, got)
+		}
+	}
+}
@@ -7,13 +7,16 @@ import (
 	"io"
 	"math"
 	"net/url"
+	"reflect"
 	"strings"
+	"time"
 
 	"golang.org/x/text/language"
 )
 
 const (
 	v21SchemaLocationURL = "https://github.com/anansi-project/comicinfo/raw/refs/heads/main/drafts/v2.1/ComicInfo.xsd"
+	v21DocumentationURL  = "https://github.com/anansi-project/comicinfo/tree/main/drafts/v2.1"
 )
 
 // ComicInfov21 represents the structure of a version 2.1 DRAFT ComicInfo.xml file.
@@ -44,7 +47,7 @@ type ComicInfov21 struct {
 	Genre               string              `xml:"Genre,omitempty"`               // Genre of the book or series. For example, Science-Fiction or Shonen. It is accepted that multiple values are comma separated.
 	Tags                string              `xml:"Tags,omitempty"`                // Tags of the book or series. For example, ninja or school life. It is accepted that multiple values are comma separated.
 	Web                 string              `xml:"Web,omitempty"`                 // A URL pointing to a reference website for the book. It is accepted that multiple values are space separated (as spaces in URL will be encoded as %20).
-	PageCount           int                 `xml:"PageCount,omitempty"`           // The number of pages in the book.
+	PageCount           int                 `xml:"PageCount,omitempty"`           // The number of pages in the book. This is an informational field which may disagree with the actual Pages list, see Pages.Count().
 	LanguageISO         string              `xml:"LanguageISO,omitempty"`         // ISO code of the language the book is written in. You can use "golang.org/x/text/language" to get valid codes, eg language.English.String()
 	Format              string              `xml:"Format,omitempty"`              // The original publication's binding format for scanned physical books or presentation format for digital sources. "TBP", "HC", "Web", "Digital" are common designators.
 	BlackAndWhite       YesNo               `xml:"BlackAndWhite,omitempty"`       // Whether the book is in black and white.
@@ -64,11 +67,24 @@ type ComicInfov21 struct {
 	GTIN                string              `xml:"GTIN,omitempty"`                // A Global Trade Item Number identifying the book. GTIN incorporates other standards like ISBN, ISSN, EAN, or JAN.
 }
 
+// NewComicInfov21 returns a v2.1 DRAFT ComicInfo with the enumerated fields explicitly set to their unknown value.
+func NewComicInfov21() ComicInfov21 {
+	return ComicInfov21{
+		BlackAndWhite: Unknown,
+		Manga:         MangaUnknown,
+		AgeRating:     AgeRatingUnknown,
+	}
+}
+
 // Encode will produce a ComicInfo v2.1 DRAFT XML content. It will validate the ComicInfo struct before encoding it into XML format.
 func (ci ComicInfov21) Encode(output io.Writer) (err error) {
 	if output == nil {
 		return errors.New("output cannot be nil")
 	}
+	// Fill in the page count if the user did not
+	if ci.PageCount == 0 && len(ci.Pages.Pages) > 0 {
+		ci.SyncPageCount()
+	}
 	// Validate some fields before encoding
 	if err = ci.Validate(); err != nil {
 		return fmt.Errorf("validation failed: %w", err)
@@ -103,45 +119,504 @@ func (ci ComicInfov21) MarshalXML(e *xml.Encoder, start xml.StartElement) error
 	}, start)
 }
 
+// SpecDocumentationURL returns the link to the documentation of the v2.1 draft, for example to refer users to it.
+func (ci ComicInfov21) SpecDocumentationURL() string {
+	return v21DocumentationURL
+}
+
+// IsEmpty returns true if no field has been set. An empty (but non nil) pages list is considered empty too.
+func (ci ComicInfov21) IsEmpty() bool {
+	if len(ci.Pages.Pages) > 0 {
+		return false
+	}
+	ci.Pages.Pages = nil
+	return reflect.ValueOf(ci).IsZero()
+}
+
+// SyncPageCount sets PageCount to the number of pages within Pages.
+// Encode() does it automatically when PageCount is not set.
+func (ci *ComicInfov21) SyncPageCount() {
+	ci.PageCount = len(ci.Pages.Pages)
+}
+
+// SetBlackAndWhite sets the BlackAndWhite field to Yes or No.
+func (ci *ComicInfov21) SetBlackAndWhite(bw bool) {
+	if bw {
+		ci.BlackAndWhite = Yes
+	} else {
+		ci.BlackAndWhite = No
+	}
+}
+
+// SetManga sets the Manga field: MangaYesAndRightToLeft if the book is a manga read from right to left,
+// MangaYes if it is a manga read from left to right and MangaNo otherwise.
+func (ci *ComicInfov21) SetManga(isManga bool, rightToLeft bool) {
+	switch {
+	case isManga && rightToLeft:
+		ci.Manga = MangaYesAndRightToLeft
+	case isManga:
+		ci.Manga = MangaYes
+	default:
+		ci.Manga = MangaNo
+	}
+}
+
+// DateString returns the release date as an ISO 8601 string: "YYYY-MM-DD", "YYYY-MM" or "YYYY" depending on which fields are set.
+// It returns an empty string if Year is not set.
+func (ci ComicInfov21) DateString() string {
+	return formatDate(ci.Year, ci.Month, ci.Day)
+}
+
+// SetDate sets the Year, Month and Day fields from the given release date.
+func (ci *ComicInfov21) SetDate(t time.Time) {
+	ci.Year = t.Year()
+	ci.Month = int(t.Month())
+	ci.Day = t.Day()
+}
+
+// SetDateFields sets the Year, Month and Day fields after checking they form an existing calendar date.
+// Month and Day can be 0 for partial dates. Nothing is modified if an error is returned.
+func (ci *ComicInfov21) SetDateFields(year, month, day int) error {
+	if err := validateCalendarDate(year, month, day); err != nil {
+		return err
+	}
+	ci.Year = year
+	ci.Month = month
+	ci.Day = day
+	return nil
+}
+
+// GetPublishDate returns the release date at UTC midnight or nil if Year is not set. Month and Day default to 1 if not set.
+func (ci ComicInfov21) GetPublishDate() *time.Time {
+	return publishDate(ci.Year, ci.Month, ci.Day)
+}
+
+// WebURLCount returns the number of space separated URLs within the Web field.
+func (ci ComicInfov21) WebURLCount() int {
+	return webURLCount(ci.Web)
+}
+
+// FirstWebURL returns the first URL of the Web field, usually the primary reference website of the book.
+// An error is returned if the field is empty or if the first URL can not be parsed.
+func (ci ComicInfov21) FirstWebURL() (*url.URL, error) {
+	return firstWebURL(ci.Web)
+}
+
+// SetWebURLs replaces the Web field with the given URLs, nil ones being skipped.
+func (ci *ComicInfov21) SetWebURLs(urls []*url.URL) {
+	ci.Web = formatWebURLs(urls)
+}
+
+// SetLanguageFromTag sets the LanguageISO field from a language tag, eg. language.BrazilianPortuguese.
+func (ci *ComicInfov21) SetLanguageFromTag(tag language.Tag) {
+	ci.LanguageISO = tag.String()
+}
+
+// SetLanguageCode sets the LanguageISO field to the canonical form of code (see NormalizeLanguageCode).
+// The field is left untouched if code is not a valid BCP 47 tag.
+func (ci *ComicInfov21) SetLanguageCode(code string) error {
+	normalized, err := NormalizeLanguageCode(code)
+	if err != nil {
+		return err
+	}
+	ci.LanguageISO = normalized
+	return nil
+}
+
 // Validate checks if some of the fields with particular constraints are valid. It returns an error if any field fails validation.
-func (ci ComicInfov21) Validate() (err error) {
+// All the failures are joined within the returned error, use ValidateAll() to get them individually.
+func (ci ComicInfov21) Validate() error {
+	return errors.Join(ci.ValidateAll()...)
+}
+
+// ValidateAll runs every validation check and returns all the failures. It returns nil if no check failed.
+func (ci ComicInfov21) ValidateAll() (errs []error) {
+	var err error
 	// URL(s)
-	for index, URL := range strings.Split(ci.Web, " ") {
-		if _, err = url.Parse(URL); err != nil {
-			return fmt.Errorf("failed to validate URL #%d: %w", index, err)
-		}
-	}
+	errs = append(errs, validateWebURLs(ci.Web)...)
 	// Language
 	if ci.LanguageISO != "" {
-		if _, err = language.Parse(ci.LanguageISO); err != nil {
-			return fmt.Errorf("failed to validate Language: %s", ci.LanguageISO)
+		if err = ValidateLanguageCode(ci.LanguageISO); err != nil {
+			errs = append(errs, fmt.Errorf("failed to validate Language: %w", err))
 		}
 	}
+	// Numbering
+	errs = append(errs, validateNumbering(ci.Number, ci.Count, ci.Volume, ci.AlternateNumber, ci.AlternateCount)...)
+	if err = validateAlternateSeries(ci.AlternateSeries, ci.AlternateNumber, ci.AlternateCount); err != nil {
+		errs = append(errs, err)
+	}
+	// Creators
+	if err = ci.ValidateCreatorFields(); err != nil {
+		errs = append(errs, fmt.Errorf("failed to validate creators: %w", err))
+	}
+	// Date
+	if err = validatePartialDate(ci.Year, ci.Month, ci.Day); err != nil {
+		errs = append(errs, fmt.Errorf("failed to validate date: %w", err))
+	}
+	if ci.Month < 0 || ci.Month > 12 {
+		errs = append(errs, fmt.Errorf("failed to validate Month: %d is not within 1-12", ci.Month))
+	}
+	if ci.Day < 0 || ci.Day > 31 {
+		errs = append(errs, fmt.Errorf("failed to validate Day: %d is not within 1-31", ci.Day))
+	}
 	// BlackAndWhite
 	if !ci.BlackAndWhite.IsValid() {
-		return fmt.Errorf("failed to validate BlackAndWhite: unknown value %q", ci.BlackAndWhite)
+		errs = append(errs, fmt.Errorf("failed to validate BlackAndWhite: unknown value %q", ci.BlackAndWhite))
 	}
 	// Manga
 	if !ci.Manga.IsValid() {
-		return fmt.Errorf("failed to validate Manga: unknown value %q", ci.Manga)
+		errs = append(errs, fmt.Errorf("failed to validate Manga: unknown value %q", ci.Manga))
 	}
 	// Age Rating
 	if !ci.AgeRating.IsValid() {
-		return fmt.Errorf("failed to validate AgeRating: unknown value %q", ci.AgeRating)
+		errs = append(errs, fmt.Errorf("failed to validate AgeRating: unknown value %q", ci.AgeRating))
 	}
 	// Pages
 	if err = ci.Pages.Validate(); err != nil {
-		return fmt.Errorf("failed to validate Pages: %w", err)
+		errs = append(errs, fmt.Errorf("failed to validate Pages: %w", err))
 	}
 	// Community Rating
 	if !ci.CommunityRating.IsValid() {
-		return fmt.Errorf("failed to validate CommunityRating: invalid value %f", *ci.CommunityRating)
+		errs = append(errs, fmt.Errorf("failed to validate CommunityRating: invalid value %f", *ci.CommunityRating))
+	}
+	// GTIN
+	if ci.GTIN != "" {
+		if err = ValidateGTIN(ci.GTIN); err != nil {
+			errs = append(errs, fmt.Errorf("failed to validate GTIN: %w", err))
+		}
+	}
+	return
+}
+
+// TrimCommaFields normalizes every comma separated field (creators, Genre, Tags, Characters, etc.) using NormalizeCommaField.
+// StoryArc and StoryArcNumber are left untouched as their values are paired by position.
+func (ci *ComicInfov21) TrimCommaFields() {
+	for _, field := range []*string{
+		&ci.Writer, &ci.Penciller, &ci.Inker, &ci.Colorist, &ci.Letterer, &ci.CoverArtist, &ci.Editor, &ci.Translator,
+		&ci.Genre, &ci.Tags, &ci.Characters, &ci.Teams, &ci.Locations, &ci.SeriesGroup,
+	} {
+		*field = NormalizeCommaField(*field)
+	}
+}
+
+// GetTags returns the values of the comma separated Tags field.
+func (ci ComicInfov21) GetTags() []string {
+	return ParseCommaField(ci.Tags)
+}
+
+// SetTags replaces the Tags field with the given values.
+func (ci *ComicInfov21) SetTags(tags []string) {
+	ci.Tags = FormatCommaField(tags)
+}
+
+// AddTag adds a tag to the Tags field if not already present (case-insensitive).
+func (ci *ComicInfov21) AddTag(tag string) {
+	addCommaValue(&ci.Tags, tag)
+}
+
+// RemoveTag removes a tag from the Tags field (case-insensitive). It returns false if the tag was not found.
+func (ci *ComicInfov21) RemoveTag(tag string) bool {
+	return removeCommaValue(&ci.Tags, tag)
+}
+
+// HasTag returns true if the tag is present in the Tags field (case-insensitive).
+func (ci ComicInfov21) HasTag(tag string) bool {
+	return hasCommaValue(ci.Tags, tag)
+}
+
+// GetSeriesGroups returns the values of the comma separated SeriesGroup field.
+func (ci ComicInfov21) GetSeriesGroups() []string {
+	return ParseCommaField(ci.SeriesGroup)
+}
+
+// SetSeriesGroups replaces the SeriesGroup field with the given values.
+func (ci *ComicInfov21) SetSeriesGroups(seriesGroups []string) {
+	ci.SeriesGroup = FormatCommaField(seriesGroups)
+}
+
+// AddSeriesGroup adds a group to the SeriesGroup field if not already present (case-insensitive).
+func (ci *ComicInfov21) AddSeriesGroup(seriesGroup string) {
+	addCommaValue(&ci.SeriesGroup, seriesGroup)
+}
+
+// RemoveSeriesGroup removes a group from the SeriesGroup field (case-insensitive). It returns false if it was not found.
+func (ci *ComicInfov21) RemoveSeriesGroup(seriesGroup string) bool {
+	return removeCommaValue(&ci.SeriesGroup, seriesGroup)
+}
+
+// HasSeriesGroup returns true if a group is present in the SeriesGroup field (case-insensitive).
+func (ci ComicInfov21) HasSeriesGroup(seriesGroup string) bool {
+	return hasCommaValue(ci.SeriesGroup, seriesGroup)
+}
+
+// GetPencillers returns the values of the comma separated Penciller field.
+func (ci ComicInfov21) GetPencillers() []string {
+	return ParseCommaField(ci.Penciller)
+}
+
+// SetPencillers replaces the Penciller field with the given values.
+func (ci *ComicInfov21) SetPencillers(pencillers []string) {
+	ci.Penciller = FormatCommaField(pencillers)
+}
+
+// AddPenciller adds a penciller to the Penciller field if not already present (case-insensitive).
+func (ci *ComicInfov21) AddPenciller(penciller string) {
+	addCommaValue(&ci.Penciller, penciller)
+}
+
+// RemovePenciller removes a penciller from the Penciller field (case-insensitive). It returns false if it was not found.
+func (ci *ComicInfov21) RemovePenciller(penciller string) bool {
+	return removeCommaValue(&ci.Penciller, penciller)
+}
+
+// HasPenciller returns true if a penciller is present in the Penciller field (case-insensitive).
+func (ci ComicInfov21) HasPenciller(penciller string) bool {
+	return hasCommaValue(ci.Penciller, penciller)
+}
+
+// GetColorists returns the values of the comma separated Colorist field.
+func (ci ComicInfov21) GetColorists() []string {
+	return ParseCommaField(ci.Colorist)
+}
+
+// SetColorists replaces the Colorist field with the given values.
+func (ci *ComicInfov21) SetColorists(colorists []string) {
+	ci.Colorist = FormatCommaField(colorists)
+}
+
+// AddColorist adds a colorist to the Colorist field if not already present (case-insensitive).
+func (ci *ComicInfov21) AddColorist(colorist string) {
+	addCommaValue(&ci.Colorist, colorist)
+}
+
+// RemoveColorist removes a colorist from the Colorist field (case-insensitive). It returns false if it was not found.
+func (ci *ComicInfov21) RemoveColorist(colorist string) bool {
+	return removeCommaValue(&ci.Colorist, colorist)
+}
+
+// HasColorist returns true if a colorist is present in the Colorist field (case-insensitive).
+func (ci ComicInfov21) HasColorist(colorist string) bool {
+	return hasCommaValue(ci.Colorist, colorist)
+}
+
+// GetInkers returns the values of the comma separated Inker field.
+func (ci ComicInfov21) GetInkers() []string {
+	return ParseCommaField(ci.Inker)
+}
+
+// SetInkers replaces the Inker field with the given values.
+func (ci *ComicInfov21) SetInkers(inkers []string) {
+	ci.Inker = FormatCommaField(inkers)
+}
+
+// AddInker adds an inker to the Inker field if not already present (case-insensitive).
+func (ci *ComicInfov21) AddInker(inker string) {
+	addCommaValue(&ci.Inker, inker)
+}
+
+// RemoveInker removes an inker from the Inker field (case-insensitive). It returns false if it was not found.
+func (ci *ComicInfov21) RemoveInker(inker string) bool {
+	return removeCommaValue(&ci.Inker, inker)
+}
+
+// HasInker returns true if an inker is present in the Inker field (case-insensitive).
+func (ci ComicInfov21) HasInker(inker string) bool {
+	return hasCommaValue(ci.Inker, inker)
+}
+
+// GetLetterers returns the values of the comma separated Letterer field.
+func (ci ComicInfov21) GetLetterers() []string {
+	return ParseCommaField(ci.Letterer)
+}
+
+// SetLetterers replaces the Letterer field with the given values.
+func (ci *ComicInfov21) SetLetterers(letterers []string) {
+	ci.Letterer = FormatCommaField(letterers)
+}
+
+// AddLetterer adds a letterer to the Letterer field if not already present (case-insensitive).
+func (ci *ComicInfov21) AddLetterer(letterer string) {
+	addCommaValue(&ci.Letterer, letterer)
+}
+
+// RemoveLetterer removes a letterer from the Letterer field (case-insensitive). It returns false if it was not found.
+func (ci *ComicInfov21) RemoveLetterer(letterer string) bool {
+	return removeCommaValue(&ci.Letterer, letterer)
+}
+
+// HasLetterer returns true if a letterer is present in the Letterer field (case-insensitive).
+func (ci ComicInfov21) HasLetterer(letterer string) bool {
+	return hasCommaValue(ci.Letterer, letterer)
+}
+
+// GetCoverArtists returns the values of the comma separated CoverArtist field.
+func (ci ComicInfov21) GetCoverArtists() []string {
+	return ParseCommaField(ci.CoverArtist)
+}
+
+// SetCoverArtists replaces the CoverArtist field with the given values.
+func (ci *ComicInfov21) SetCoverArtists(coverArtists []string) {
+	ci.CoverArtist = FormatCommaField(coverArtists)
+}
+
+// AddCoverArtist adds a cover artist to the CoverArtist field if not already present (case-insensitive).
+func (ci *ComicInfov21) AddCoverArtist(coverArtist string) {
+	addCommaValue(&ci.CoverArtist, coverArtist)
+}
+
+// RemoveCoverArtist removes a cover artist from the CoverArtist field (case-insensitive). It returns false if it was not found.
+func (ci *ComicInfov21) RemoveCoverArtist(coverArtist string) bool {
+	return removeCommaValue(&ci.CoverArtist, coverArtist)
+}
+
+// HasCoverArtist returns true if a cover artist is present in the CoverArtist field (case-insensitive).
+func (ci ComicInfov21) HasCoverArtist(coverArtist string) bool {
+	return hasCommaValue(ci.CoverArtist, coverArtist)
+}
+
+// GetEditors returns the values of the comma separated Editor field.
+func (ci ComicInfov21) GetEditors() []string {
+	return ParseCommaField(ci.Editor)
+}
+
+// SetEditors replaces the Editor field with the given values.
+func (ci *ComicInfov21) SetEditors(editors []string) {
+	ci.Editor = FormatCommaField(editors)
+}
+
+// AddEditor adds an editor to the Editor field if not already present (case-insensitive).
+func (ci *ComicInfov21) AddEditor(editor string) {
+	addCommaValue(&ci.Editor, editor)
+}
+
+// RemoveEditor removes an editor from the Editor field (case-insensitive). It returns false if it was not found.
+func (ci *ComicInfov21) RemoveEditor(editor string) bool {
+	return removeCommaValue(&ci.Editor, editor)
+}
+
+// HasEditor returns true if an editor is present in the Editor field (case-insensitive).
+func (ci ComicInfov21) HasEditor(editor string) bool {
+	return hasCommaValue(ci.Editor, editor)
+}
+
+// GetTranslators returns the values of the comma separated Translator field.
+func (ci ComicInfov21) GetTranslators() []string {
+	return ParseCommaField(ci.Translator)
+}
+
+// SetTranslators replaces the Translator field with the given values.
+func (ci *ComicInfov21) SetTranslators(translators []string) {
+	ci.Translator = FormatCommaField(translators)
+}
+
+// AddTranslator adds a translator to the Translator field if not already present (case-insensitive).
+func (ci *ComicInfov21) AddTranslator(translator string) {
+	addCommaValue(&ci.Translator, translator)
+}
+
+// RemoveTranslator removes a translator from the Translator field (case-insensitive). It returns false if it was not found.
+func (ci *ComicInfov21) RemoveTranslator(translator string) bool {
+	return removeCommaValue(&ci.Translator, translator)
+}
+
+// HasTranslator returns true if a translator is present in the Translator field (case-insensitive).
+func (ci ComicInfov21) HasTranslator(translator string) bool {
+	return hasCommaValue(ci.Translator, translator)
+}
+
+// StoryArcPair represents a story arc (or reading order) and the position of the book within it.
+type StoryArcPair struct {
+	Arc    string
+	Number string
+}
+
+// GetStoryArcPairs zips the comma separated StoryArc and StoryArcNumber fields. Number is empty if StoryArcNumber has less values than StoryArc.
+func (ci ComicInfov21) GetStoryArcPairs() (pairs []StoryArcPair) {
+	if strings.TrimSpace(ci.StoryArc) == "" {
+		return
+	}
+	arcs := strings.Split(ci.StoryArc, ",")
+	numbers := strings.Split(ci.StoryArcNumber, ",")
+	pairs = make([]StoryArcPair, 0, len(arcs))
+	for i, arc := range arcs {
+		pair := StoryArcPair{
+			Arc: strings.TrimSpace(arc),
+		}
+		if pair.Arc == "" {
+			continue
+		}
+		if i < len(numbers) {
+			pair.Number = strings.TrimSpace(numbers[i])
+		}
+		pairs = append(pairs, pair)
+	}
+	return
+}
+
+// SetStoryArcPairs replaces both StoryArc and StoryArcNumber fields, keeping their values aligned.
+func (ci *ComicInfov21) SetStoryArcPairs(pairs []StoryArcPair) {
+	arcs := make([]string, 0, len(pairs))
+	numbers := make([]string, 0, len(pairs))
+	var hasNumber bool
+	for _, pair := range pairs {
+		if pair.Arc = strings.TrimSpace(pair.Arc); pair.Arc == "" {
+			continue
+		}
+		pair.Number = strings.TrimSpace(pair.Number)
+		arcs = append(arcs, pair.Arc)
+		numbers = append(numbers, pair.Number)
+		if pair.Number != "" {
+			hasNumber = true
+		}
+	}
+	ci.StoryArc = strings.Join(arcs, ", ")
+	if hasNumber {
+		ci.StoryArcNumber = strings.Join(numbers, ", ")
+	} else {
+		ci.StoryArcNumber = ""
+	}
+}
+
+// AddStoryArcPair appends a story arc pair. If the arc is already present (case-insensitive), its number is updated instead.
+func (ci *ComicInfov21) AddStoryArcPair(p StoryArcPair) {
+	pairs := ci.GetStoryArcPairs()
+	for i, pair := range pairs {
+		if strings.EqualFold(pair.Arc, strings.TrimSpace(p.Arc)) {
+			pairs[i].Number = p.Number
+			ci.SetStoryArcPairs(pairs)
+			return
+		}
+	}
+	ci.SetStoryArcPairs(append(pairs, p))
+}
+
+// RemoveStoryArcPair removes the story arc (case-insensitive) along with its number. It returns false if the arc was not found.
+func (ci *ComicInfov21) RemoveStoryArcPair(arc string) (removed bool) {
+	pairs := ci.GetStoryArcPairs()
+	kept := pairs[:0]
+	for _, pair := range pairs {
+		if strings.EqualFold(pair.Arc, strings.TrimSpace(arc)) {
+			removed = true
+			continue
+		}
+		kept = append(kept, pair)
+	}
+	if removed {
+		ci.SetStoryArcPairs(kept)
 	}
 	return
 }
 
 type CommunityRatingV21 float64
 
+// String returns the rating formatted as "4.5/5.0" or "unrated" if nil.
+func (cr *CommunityRatingV21) String() string {
+	if cr == nil {
+		return "unrated"
+	}
+	return fmt.Sprintf("%.1f/5.0", float64(*cr))
+}
+
 func (cr *CommunityRatingV21) IsValid() bool {
 	if cr == nil {
 		return true
@@ -1,19 +1,27 @@
 package comicinfo
 
 import (
+	"bytes"
 	"encoding/xml"
 	"errors"
 	"fmt"
+	"image"
 	"io"
 	"math"
 	"net/url"
+	"reflect"
+	"runtime"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"golang.org/x/text/language"
 )
 
 const (
 	v2SchemaLocationURL = "https://raw.githubusercontent.com/anansi-project/comicinfo/refs/heads/main/schema/v2.0/ComicInfo.xsd"
+	v2DocumentationURL  = "https://github.com/anansi-project/comicinfo/tree/main/schema/v2.0"
 )
 
 // ComicInfov2 represents the structure of a version 2 ComicInfo.xml file.
@@ -42,7 +50,7 @@ type ComicInfov2 struct {
 	Imprint             string           `xml:"Imprint,omitempty"`             // An imprint is a group of publications under the umbrella of a larger imprint or a Publisher. For example, Vertigo is an Imprint of DC Comics.
 	Genre               string           `xml:"Genre,omitempty"`               // Genre of the book or series. For example, Science-Fiction or Shonen. It is accepted that multiple values are comma separated.
 	Web                 string           `xml:"Web,omitempty"`                 // A URL pointing to a reference website for the book. It is accepted that multiple values are space separated (as spaces in URL will be encoded as %20).
-	PageCount           int              `xml:"PageCount,omitempty"`           // The number of pages in the book.
+	PageCount           int              `xml:"PageCount,omitempty"`           // The number of pages in the book. This is an informational field which may disagree with the actual Pages list, see Pages.Count().
 	LanguageISO         string           `xml:"LanguageISO,omitempty"`         // ISO code of the language the book is written in. You can use "golang.org/x/text/language" to get valid codes, eg language.English.String()
 	Format              string           `xml:"Format,omitempty"`              // The original publication's binding format for scanned physical books or presentation format for digital sources. "TBP", "HC", "Web", "Digital" are common designators.
 	BlackAndWhite       YesNo            `xml:"BlackAndWhite,omitempty"`       // Whether the book is in black and white.
@@ -58,25 +66,88 @@ type ComicInfov2 struct {
 	CommunityRating     *CommunityRating `xml:"CommunityRating,omitempty"`     // Community rating of the book, from 0.0 to 5.0, 2 digits allowed.
 	MainCharacterOrTeam string           `xml:"MainCharacterOrTeam,omitempty"` // Main character or team mentioned in the book. It is accepted that a single value should be present.
 	Review              string           `xml:"Review,omitempty"`              // Review of the book.
+	IssueNumberStr      IssueNumber      `xml:"-" json:",omitempty"`           // Non standard: issue number which can not be represented by the Number int field, eg. "1.5" or "Annual". When set, it is written within the Number element (a string for the schemas) and non integer Number elements are decoded into it.
+	// Extensions: non standard fields, only written to the XML output when EncodeOptions.IncludeExtensions is set.
+	WordCount int      `xml:"-" json:",omitempty"` // Non standard: number of words of the story, for accessibility and reading time estimates.
+	Rating    *float64 `xml:"-" json:",omitempty"` // Non standard: editorial or critic rating, from 0.0 to 10.0 with 1 digit allowed (see NewRating). Distinct from the crowd sourced CommunityRating.
+}
+
+// NewComicInfov2 returns a v2 ComicInfo with the enumerated fields explicitly set to their unknown value.
+// Options are then applied in order and the result is validated.
+func NewComicInfov2(opts ...ComicInfoOption) (ci ComicInfov2, err error) {
+	ci = ComicInfov2{
+		BlackAndWhite: Unknown,
+		Manga:         MangaUnknown,
+		AgeRating:     AgeRatingUnknown,
+	}
+	for _, opt := range opts {
+		opt(&ci)
+	}
+	if err = ci.Validate(); err != nil {
+		err = fmt.Errorf("validation failed: %w", err)
+	}
+	return
 }
 
 // Encode will produce a ComicInfo v2 XML content. It will validate the ComicInfo struct before encoding it into XML format.
 func (ci ComicInfov2) Encode(output io.Writer) (err error) {
+	return ci.EncodeWithOptions(output, EncodeOptions{})
+}
+
+// EncodeWithSchemaURL is Encode with a custom schema location, for example a copy of the v2 schema hosted internally.
+func (ci ComicInfov2) EncodeWithSchemaURL(output io.Writer, schemaURL string) (err error) {
+	if schemaURL == "" {
+		return errors.New("schema URL cannot be empty")
+	}
+	return ci.EncodeWithOptions(output, EncodeOptions{SchemaURL: schemaURL})
+}
+
+// EncodeWithoutSchema is Encode without the xmlns:xsi and xsi:schemaLocation attributes on the root element,
+// for consumers which do not handle them properly.
+func (ci ComicInfov2) EncodeWithoutSchema(output io.Writer) (err error) {
+	return ci.EncodeWithOptions(output, EncodeOptions{OmitSchema: true})
+}
+
+// CompactEncode is Encode without any indentation nor newline, producing the smallest possible document.
+func (ci ComicInfov2) CompactEncode(output io.Writer) (err error) {
+	return ci.EncodeWithOptions(output, EncodeOptions{Compact: true})
+}
+
+// EncodeWithOptions is Encode with a customized output, see EncodeOptions.
+func (ci ComicInfov2) EncodeWithOptions(output io.Writer, opts EncodeOptions) (err error) {
+	if err = opts.validate(); err != nil {
+		return fmt.Errorf("invalid options: %w", err)
+	}
 	if output == nil {
 		return errors.New("output cannot be nil")
 	}
+	// Fill in the page count if the user did not
+	if ci.PageCount == 0 && len(ci.Pages.Pages) > 0 {
+		ci.SyncPageCount()
+	}
 	// Validate some fields before encoding
 	if err = ci.Validate(); err != nil {
 		return fmt.Errorf("validation failed: %w", err)
 	}
+	if opts.IncludeExtensions {
+		if err = ci.ValidateExtensions(); err != nil {
+			return fmt.Errorf("extensions validation failed: %w", err)
+		}
+	}
 	// Write header
-	if _, err = output.Write([]byte(xml.Header)); err != nil {
+	header := xml.Header
+	if opts.Compact {
+		header = strings.TrimSuffix(header, "\n")
+	}
+	if _, err = output.Write([]byte(header)); err != nil {
 		return fmt.Errorf("failed to write XML header: %w", err)
 	}
 	// Encode
 	encoder := xml.NewEncoder(output)
-	encoder.Indent("", "\t")
-	if err := encoder.Encode(ci); err != nil {
+	if !opts.Compact {
+		encoder.Indent("", "\t")
+	}
+	if err := encoder.Encode(comicInfov2Options{ci: ci, opts: opts}); err != nil {
 		return fmt.Errorf("failed to encode ComicInfo v2 XML: %w", err)
 	}
 	return
@@ -85,57 +156,569 @@ func (ci ComicInfov2) Encode(output io.Writer) (err error) {
 // MarshalXML implements the xml.Marshaler interface to automatically add schema attributes.
 // User should use Encode() instead of this method directly. This method is used internally by Encode().
 func (ci ComicInfov2) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return ci.marshalXML(e, start, EncodeOptions{})
+}
+
+func (ci ComicInfov2) marshalXML(e *xml.Encoder, start xml.StartElement, opts EncodeOptions) error {
 	start.Name.Local = "ComicInfo" // Correct name for root name
 	type Mask ComicInfov2
 	type attr struct {
 		Mask
-		XSI            string `xml:"xmlns:xsi,attr"`
-		SchemaLocation string `xml:"xsi:schemaLocation,attr"`
+		XSI            string `xml:"xmlns:xsi,attr,omitempty"`
+		SchemaLocation string `xml:"xsi:schemaLocation,attr,omitempty"`
+		comicInfov2Extensions
+	}
+	root := attr{
+		Mask: Mask(ci),
+	}
+	if !opts.OmitSchema {
+		root.XSI = xmlnsxni
+		root.SchemaLocation = opts.schemaURL(v2SchemaLocationURL)
+	}
+	if opts.IncludeExtensions {
+		root.comicInfov2Extensions = ci.extensions()
+	}
+	if ci.IssueNumberStr == "" {
+		return e.EncodeElement(root, start)
+	}
+	// Number is an int: encode a placeholder and replace its content by IssueNumberStr
+	root.Number = 1
+	var buffer bytes.Buffer
+	if err := xml.NewEncoder(&buffer).EncodeElement(root, start); err != nil {
+		return err
 	}
-	return e.EncodeElement(attr{
-		Mask:           Mask(ci),
-		XSI:            xmlnsxni,
-		SchemaLocation: v2SchemaLocationURL,
-	}, start)
+	return copyTokensWithNumber(e, xml.NewDecoder(&buffer), string(ci.IssueNumberStr))
 }
 
-// Validate checks if some of the fields with particular constraints are valid. It returns an error if any field fails validation.
-func (ci ComicInfov2) Validate() (err error) {
-	// URL(s)
-	for index, URL := range strings.Split(ci.Web, " ") {
-		if _, err = url.Parse(URL); err != nil {
-			return fmt.Errorf("failed to validate URL #%d: %w", index, err)
+// copyTokensWithNumber copies the tokens of a document to e, replacing the content of the root level Number element.
+// Raw tokens are used to keep the prefixed attributes (eg. xsi:schemaLocation) as written.
+func copyTokensWithNumber(e *xml.Encoder, d *xml.Decoder, number string) error {
+	var (
+		depth    int
+		inNumber bool
+	)
+	for {
+		token, err := d.RawToken()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			depth++
+			inNumber = depth == 2 && t.Name.Local == "Number"
+			for i, attr := range t.Attr {
+				if attr.Name.Space != "" {
+					t.Attr[i].Name = xml.Name{Local: attr.Name.Space + ":" + attr.Name.Local}
+				}
+			}
+			token = t
+		case xml.EndElement:
+			depth--
+			inNumber = false
+		case xml.CharData:
+			if inNumber {
+				token = xml.CharData(number)
+			}
+		}
+		if err = e.EncodeToken(token); err != nil {
+			return err
+		}
+	}
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface. It decodes the struct fields and the extensions, the schema attributes being ignored.
+// A Number element which is not an integer (eg. "1.5" or "Annual") is decoded into IssueNumberStr.
+func (ci *ComicInfov2) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type Mask ComicInfov2
+	type ext struct {
+		*Mask
+		Number string `xml:"Number"` // shadows Mask.Number to accept non integer values
+		comicInfov2Extensions
+	}
+	decoded := ext{Mask: (*Mask)(ci)}
+	if err := d.DecodeElement(&decoded, &start); err != nil {
+		return err
+	}
+	if number := strings.TrimSpace(decoded.Number); number != "" {
+		if value, err := strconv.Atoi(number); err == nil {
+			ci.Number = value
+		} else {
+			ci.IssueNumberStr = IssueNumber(number)
 		}
 	}
+	return ci.setExtensions(decoded.comicInfov2Extensions)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface by returning the same XML document as Encode().
+func (ci ComicInfov2) MarshalText() ([]byte, error) {
+	var buffer bytes.Buffer
+	if err := ci.Encode(&buffer); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface by parsing an XML document such as the one produced by MarshalText.
+func (ci *ComicInfov2) UnmarshalText(text []byte) error {
+	var decoded ComicInfov2
+	if err := xml.Unmarshal(text, &decoded); err != nil {
+		return fmt.Errorf("failed to decode ComicInfo v2 XML: %w", err)
+	}
+	*ci = decoded
+	return nil
+}
+
+// comicInfov2Options marshals a ComicInfov2 with custom encoding options.
+type comicInfov2Options struct {
+	ci   ComicInfov2
+	opts EncodeOptions
+}
+
+func (cio comicInfov2Options) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return cio.ci.marshalXML(e, start, cio.opts)
+}
+
+// SpecDocumentationURL returns the link to the documentation of the v2 schema, for example to refer users to it.
+func (ci ComicInfov2) SpecDocumentationURL() string {
+	return v2DocumentationURL
+}
+
+// IsEmpty returns true if no field has been set. An empty (but non nil) pages list is considered empty too.
+func (ci ComicInfov2) IsEmpty() bool {
+	if len(ci.Pages.Pages) > 0 {
+		return false
+	}
+	ci.Pages.Pages = nil
+	return reflect.ValueOf(ci).IsZero()
+}
+
+// SyncPageCount sets PageCount to the number of pages within Pages.
+// Encode() does it automatically when PageCount is not set.
+func (ci *ComicInfov2) SyncPageCount() {
+	ci.PageCount = len(ci.Pages.Pages)
+}
+
+// SetBlackAndWhite sets the BlackAndWhite field to Yes or No.
+func (ci *ComicInfov2) SetBlackAndWhite(bw bool) {
+	if bw {
+		ci.BlackAndWhite = Yes
+	} else {
+		ci.BlackAndWhite = No
+	}
+}
+
+// SetManga sets the Manga field: MangaYesAndRightToLeft if the book is a manga read from right to left,
+// MangaYes if it is a manga read from left to right and MangaNo otherwise.
+func (ci *ComicInfov2) SetManga(isManga bool, rightToLeft bool) {
+	switch {
+	case isManga && rightToLeft:
+		ci.Manga = MangaYesAndRightToLeft
+	case isManga:
+		ci.Manga = MangaYes
+	default:
+		ci.Manga = MangaNo
+	}
+}
+
+// DateString returns the release date as an ISO 8601 string: "YYYY-MM-DD", "YYYY-MM" or "YYYY" depending on which fields are set.
+// It returns an empty string if Year is not set.
+func (ci ComicInfov2) DateString() string {
+	return formatDate(ci.Year, ci.Month, ci.Day)
+}
+
+// SetDate sets the Year, Month and Day fields from the given release date.
+func (ci *ComicInfov2) SetDate(t time.Time) {
+	ci.Year = t.Year()
+	ci.Month = int(t.Month())
+	ci.Day = t.Day()
+}
+
+// SetDateFields sets the Year, Month and Day fields after checking they form an existing calendar date.
+// Month and Day can be 0 for partial dates. Nothing is modified if an error is returned.
+func (ci *ComicInfov2) SetDateFields(year, month, day int) error {
+	if err := validateCalendarDate(year, month, day); err != nil {
+		return err
+	}
+	ci.Year = year
+	ci.Month = month
+	ci.Day = day
+	return nil
+}
+
+// GetPublishDate returns the release date at UTC midnight or nil if Year is not set. Month and Day default to 1 if not set.
+func (ci ComicInfov2) GetPublishDate() *time.Time {
+	return publishDate(ci.Year, ci.Month, ci.Day)
+}
+
+// WebURLCount returns the number of space separated URLs within the Web field.
+func (ci ComicInfov2) WebURLCount() int {
+	return webURLCount(ci.Web)
+}
+
+// FirstWebURL returns the first URL of the Web field, usually the primary reference website of the book.
+// An error is returned if the field is empty or if the first URL can not be parsed.
+func (ci ComicInfov2) FirstWebURL() (*url.URL, error) {
+	return firstWebURL(ci.Web)
+}
+
+// HasWebURL returns true if u is already present within the Web field. URLs are compared with their scheme, host and path lowercased.
+func (ci ComicInfov2) HasWebURL(u *url.URL) bool {
+	return hasWebURL(ci.Web, u)
+}
+
+// AddWebURL appends u to the Web field unless HasWebURL reports it as already present.
+func (ci *ComicInfov2) AddWebURL(u *url.URL) {
+	addWebURL(&ci.Web, u)
+}
+
+// SetWebURLs replaces the Web field with the given URLs, nil ones being skipped.
+func (ci *ComicInfov2) SetWebURLs(urls []*url.URL) {
+	ci.Web = formatWebURLs(urls)
+}
+
+// issueNumber returns IssueNumberStr if set, Number otherwise.
+func (ci ComicInfov2) issueNumber() IssueNumber {
+	if ci.IssueNumberStr != "" {
+		return ci.IssueNumberStr
+	}
+	return IssueNumber(strconv.Itoa(ci.Number))
+}
+
+// PageCountMismatch returns true when both PageCount and the Pages list are set but disagree on the number of pages.
+func (ci ComicInfov2) PageCountMismatch() bool {
+	return ci.PageCount != 0 && ci.Pages.Count() != 0 && ci.PageCount != ci.Pages.Count()
+}
+
+// SetLanguageFromTag sets the LanguageISO field from a language tag, eg. language.BrazilianPortuguese.
+func (ci *ComicInfov2) SetLanguageFromTag(tag language.Tag) {
+	ci.LanguageISO = tag.String()
+}
+
+// SetLanguageCode sets the LanguageISO field to the canonical form of code (see NormalizeLanguageCode).
+// The field is left untouched if code is not a valid BCP 47 tag.
+func (ci *ComicInfov2) SetLanguageCode(code string) error {
+	normalized, err := NormalizeLanguageCode(code)
+	if err != nil {
+		return err
+	}
+	ci.LanguageISO = normalized
+	return nil
+}
+
+// Validate checks if some of the fields with particular constraints are valid. It returns an error if any field fails validation.
+// All the failures are joined within the returned error, use ValidateAll() to get them individually.
+func (ci ComicInfov2) Validate() error {
+	return errors.Join(ci.ValidateAll()...)
+}
+
+// ValidateAll runs every validation check and returns all the failures. It returns nil if no check failed.
+func (ci ComicInfov2) ValidateAll() (errs []error) {
+	return ci.validateAll(false)
+}
+
+// validateAll implements ValidateAll, validating the pages concurrently if parallelPages is true (see PagesV2.ValidateParallel).
+func (ci ComicInfov2) validateAll(parallelPages bool) (errs []error) {
+	var err error
+	// URL(s)
+	errs = append(errs, validateWebURLs(ci.Web)...)
 	// Language
 	if ci.LanguageISO != "" {
-		if _, err = language.Parse(ci.LanguageISO); err != nil {
-			return fmt.Errorf("failed to validate Language: %s", ci.LanguageISO)
+		if err = ValidateLanguageCode(ci.LanguageISO); err != nil {
+			errs = append(errs, fmt.Errorf("failed to validate Language: %w", err))
 		}
 	}
+	// Numbering
+	errs = append(errs, validateNumbering(ci.Number, ci.Count, ci.Volume, ci.AlternateNumber, ci.AlternateCount)...)
+	if err = validateAlternateSeries(ci.AlternateSeries, ci.AlternateNumber, ci.AlternateCount); err != nil {
+		errs = append(errs, err)
+	}
+	// Creators
+	if err = ci.ValidateCreatorFields(); err != nil {
+		errs = append(errs, fmt.Errorf("failed to validate creators: %w", err))
+	}
+	// Date
+	if err = validatePartialDate(ci.Year, ci.Month, ci.Day); err != nil {
+		errs = append(errs, fmt.Errorf("failed to validate date: %w", err))
+	}
+	if ci.Month < 0 || ci.Month > 12 {
+		errs = append(errs, fmt.Errorf("failed to validate Month: %d is not within 1-12", ci.Month))
+	}
+	if ci.Day < 0 || ci.Day > 31 {
+		errs = append(errs, fmt.Errorf("failed to validate Day: %d is not within 1-31", ci.Day))
+	}
 	// BlackAndWhite
 	if !ci.BlackAndWhite.IsValid() {
-		return fmt.Errorf("failed to validate BlackAndWhite: unknown value %q", ci.BlackAndWhite)
+		errs = append(errs, fmt.Errorf("failed to validate BlackAndWhite: unknown value %q", ci.BlackAndWhite))
 	}
 	// Manga
 	if !ci.Manga.IsValid() {
-		return fmt.Errorf("failed to validate Manga: unknown value %q", ci.Manga)
+		errs = append(errs, fmt.Errorf("failed to validate Manga: unknown value %q", ci.Manga))
 	}
 	// Age Rating
 	if !ci.AgeRating.IsValid() {
-		return fmt.Errorf("failed to validate AgeRating: unknown value %q", ci.AgeRating)
+		errs = append(errs, fmt.Errorf("failed to validate AgeRating: unknown value %q", ci.AgeRating))
 	}
 	// Pages
-	if err = ci.Pages.Validate(); err != nil {
-		return fmt.Errorf("failed to validate Pages: %w", err)
+	if parallelPages {
+		err = ci.Pages.ValidateParallel()
+	} else {
+		err = ci.Pages.Validate()
+	}
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed to validate Pages: %w", err))
 	}
 	// Community Rating
 	if !ci.CommunityRating.IsValid() {
-		return fmt.Errorf("failed to validate CommunityRating: invalid value %f", *ci.CommunityRating)
+		errs = append(errs, fmt.Errorf("failed to validate CommunityRating: invalid value %f", *ci.CommunityRating))
+	}
+	// Rating
+	if ci.Rating != nil {
+		if err = validateRating(*ci.Rating); err != nil {
+			errs = append(errs, fmt.Errorf("failed to validate Rating: %w", err))
+		}
 	}
 	return
 }
 
+// String returns a concise multi-line human readable summary of the book, useful for debugging.
+func (ci ComicInfov2) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Title: %s\n", ci.Title)
+	fmt.Fprintf(&sb, "Series: %s #%d\n", ci.Series, ci.Number)
+	if date := ci.DateString(); date != "" {
+		fmt.Fprintf(&sb, "Date: %s\n", date)
+	}
+	if ci.CommunityRating != nil {
+		fmt.Fprintf(&sb, "Community rating: %s\n", ci.CommunityRating)
+	}
+	fmt.Fprintf(&sb, "Pages: %d", ci.PageCount)
+	for _, creator := range []struct {
+		role  string
+		value string
+	}{
+		{"Writer", ci.Writer},
+		{"Penciller", ci.Penciller},
+		{"Inker", ci.Inker},
+		{"Colorist", ci.Colorist},
+		{"Letterer", ci.Letterer},
+		{"CoverArtist", ci.CoverArtist},
+		{"Editor", ci.Editor},
+	} {
+		if creator.value != "" {
+			fmt.Fprintf(&sb, "\n%s: %s", creator.role, creator.value)
+		}
+	}
+	return sb.String()
+}
+
+// TrimCommaFields normalizes every comma separated field (creators, Genre, Characters, etc.) using NormalizeCommaField.
+func (ci *ComicInfov2) TrimCommaFields() {
+	for _, field := range []*string{
+		&ci.Writer, &ci.Penciller, &ci.Inker, &ci.Colorist, &ci.Letterer, &ci.CoverArtist, &ci.Editor,
+		&ci.Genre, &ci.Characters, &ci.Teams, &ci.Locations, &ci.StoryArc, &ci.SeriesGroup,
+	} {
+		*field = NormalizeCommaField(*field)
+	}
+}
+
+// GetStoryArcs returns the values of the comma separated StoryArc field.
+func (ci ComicInfov2) GetStoryArcs() []string {
+	return ParseCommaField(ci.StoryArc)
+}
+
+// SetStoryArcs replaces the StoryArc field with the given values.
+func (ci *ComicInfov2) SetStoryArcs(storyArcs []string) {
+	ci.StoryArc = FormatCommaField(storyArcs)
+}
+
+// AddStoryArc adds a story arc to the StoryArc field if not already present (case-insensitive).
+func (ci *ComicInfov2) AddStoryArc(storyArc string) {
+	addCommaValue(&ci.StoryArc, storyArc)
+}
+
+// RemoveStoryArc removes a story arc from the StoryArc field (case-insensitive). It returns false if it was not found.
+func (ci *ComicInfov2) RemoveStoryArc(storyArc string) bool {
+	return removeCommaValue(&ci.StoryArc, storyArc)
+}
+
+// GetSeriesGroups returns the values of the comma separated SeriesGroup field.
+func (ci ComicInfov2) GetSeriesGroups() []string {
+	return ParseCommaField(ci.SeriesGroup)
+}
+
+// SetSeriesGroups replaces the SeriesGroup field with the given values.
+func (ci *ComicInfov2) SetSeriesGroups(seriesGroups []string) {
+	ci.SeriesGroup = FormatCommaField(seriesGroups)
+}
+
+// AddSeriesGroup adds a group to the SeriesGroup field if not already present (case-insensitive).
+func (ci *ComicInfov2) AddSeriesGroup(seriesGroup string) {
+	addCommaValue(&ci.SeriesGroup, seriesGroup)
+}
+
+// RemoveSeriesGroup removes a group from the SeriesGroup field (case-insensitive). It returns false if it was not found.
+func (ci *ComicInfov2) RemoveSeriesGroup(seriesGroup string) bool {
+	return removeCommaValue(&ci.SeriesGroup, seriesGroup)
+}
+
+// HasSeriesGroup returns true if a group is present in the SeriesGroup field (case-insensitive).
+func (ci ComicInfov2) HasSeriesGroup(seriesGroup string) bool {
+	return hasCommaValue(ci.SeriesGroup, seriesGroup)
+}
+
+// GetPencillers returns the values of the comma separated Penciller field.
+func (ci ComicInfov2) GetPencillers() []string {
+	return ParseCommaField(ci.Penciller)
+}
+
+// SetPencillers replaces the Penciller field with the given values.
+func (ci *ComicInfov2) SetPencillers(pencillers []string) {
+	ci.Penciller = FormatCommaField(pencillers)
+}
+
+// AddPenciller adds a penciller to the Penciller field if not already present (case-insensitive).
+func (ci *ComicInfov2) AddPenciller(penciller string) {
+	addCommaValue(&ci.Penciller, penciller)
+}
+
+// RemovePenciller removes a penciller from the Penciller field (case-insensitive). It returns false if it was not found.
+func (ci *ComicInfov2) RemovePenciller(penciller string) bool {
+	return removeCommaValue(&ci.Penciller, penciller)
+}
+
+// HasPenciller returns true if a penciller is present in the Penciller field (case-insensitive).
+func (ci ComicInfov2) HasPenciller(penciller string) bool {
+	return hasCommaValue(ci.Penciller, penciller)
+}
+
+// GetColorists returns the values of the comma separated Colorist field.
+func (ci ComicInfov2) GetColorists() []string {
+	return ParseCommaField(ci.Colorist)
+}
+
+// SetColorists replaces the Colorist field with the given values.
+func (ci *ComicInfov2) SetColorists(colorists []string) {
+	ci.Colorist = FormatCommaField(colorists)
+}
+
+// AddColorist adds a colorist to the Colorist field if not already present (case-insensitive).
+func (ci *ComicInfov2) AddColorist(colorist string) {
+	addCommaValue(&ci.Colorist, colorist)
+}
+
+// RemoveColorist removes a colorist from the Colorist field (case-insensitive). It returns false if it was not found.
+func (ci *ComicInfov2) RemoveColorist(colorist string) bool {
+	return removeCommaValue(&ci.Colorist, colorist)
+}
+
+// HasColorist returns true if a colorist is present in the Colorist field (case-insensitive).
+func (ci ComicInfov2) HasColorist(colorist string) bool {
+	return hasCommaValue(ci.Colorist, colorist)
+}
+
+// GetInkers returns the values of the comma separated Inker field.
+func (ci ComicInfov2) GetInkers() []string {
+	return ParseCommaField(ci.Inker)
+}
+
+// SetInkers replaces the Inker field with the given values.
+func (ci *ComicInfov2) SetInkers(inkers []string) {
+	ci.Inker = FormatCommaField(inkers)
+}
+
+// AddInker adds an inker to the Inker field if not already present (case-insensitive).
+func (ci *ComicInfov2) AddInker(inker string) {
+	addCommaValue(&ci.Inker, inker)
+}
+
+// RemoveInker removes an inker from the Inker field (case-insensitive). It returns false if it was not found.
+func (ci *ComicInfov2) RemoveInker(inker string) bool {
+	return removeCommaValue(&ci.Inker, inker)
+}
+
+// HasInker returns true if an inker is present in the Inker field (case-insensitive).
+func (ci ComicInfov2) HasInker(inker string) bool {
+	return hasCommaValue(ci.Inker, inker)
+}
+
+// GetLetterers returns the values of the comma separated Letterer field.
+func (ci ComicInfov2) GetLetterers() []string {
+	return ParseCommaField(ci.Letterer)
+}
+
+// SetLetterers replaces the Letterer field with the given values.
+func (ci *ComicInfov2) SetLetterers(letterers []string) {
+	ci.Letterer = FormatCommaField(letterers)
+}
+
+// AddLetterer adds a letterer to the Letterer field if not already present (case-insensitive).
+func (ci *ComicInfov2) AddLetterer(letterer string) {
+	addCommaValue(&ci.Letterer, letterer)
+}
+
+// RemoveLetterer removes a letterer from the Letterer field (case-insensitive). It returns false if it was not found.
+func (ci *ComicInfov2) RemoveLetterer(letterer string) bool {
+	return removeCommaValue(&ci.Letterer, letterer)
+}
+
+// HasLetterer returns true if a letterer is present in the Letterer field (case-insensitive).
+func (ci ComicInfov2) HasLetterer(letterer string) bool {
+	return hasCommaValue(ci.Letterer, letterer)
+}
+
+// GetCoverArtists returns the values of the comma separated CoverArtist field.
+func (ci ComicInfov2) GetCoverArtists() []string {
+	return ParseCommaField(ci.CoverArtist)
+}
+
+// SetCoverArtists replaces the CoverArtist field with the given values.
+func (ci *ComicInfov2) SetCoverArtists(coverArtists []string) {
+	ci.CoverArtist = FormatCommaField(coverArtists)
+}
+
+// AddCoverArtist adds a cover artist to the CoverArtist field if not already present (case-insensitive).
+func (ci *ComicInfov2) AddCoverArtist(coverArtist string) {
+	addCommaValue(&ci.CoverArtist, coverArtist)
+}
+
+// RemoveCoverArtist removes a cover artist from the CoverArtist field (case-insensitive). It returns false if it was not found.
+func (ci *ComicInfov2) RemoveCoverArtist(coverArtist string) bool {
+	return removeCommaValue(&ci.CoverArtist, coverArtist)
+}
+
+// HasCoverArtist returns true if a cover artist is present in the CoverArtist field (case-insensitive).
+func (ci ComicInfov2) HasCoverArtist(coverArtist string) bool {
+	return hasCommaValue(ci.CoverArtist, coverArtist)
+}
+
+// GetEditors returns the values of the comma separated Editor field.
+func (ci ComicInfov2) GetEditors() []string {
+	return ParseCommaField(ci.Editor)
+}
+
+// SetEditors replaces the Editor field with the given values.
+func (ci *ComicInfov2) SetEditors(editors []string) {
+	ci.Editor = FormatCommaField(editors)
+}
+
+// AddEditor adds an editor to the Editor field if not already present (case-insensitive).
+func (ci *ComicInfov2) AddEditor(editor string) {
+	addCommaValue(&ci.Editor, editor)
+}
+
+// RemoveEditor removes an editor from the Editor field (case-insensitive). It returns false if it was not found.
+func (ci *ComicInfov2) RemoveEditor(editor string) bool {
+	return removeCommaValue(&ci.Editor, editor)
+}
+
+// HasEditor returns true if an editor is present in the Editor field (case-insensitive).
+func (ci ComicInfov2) HasEditor(editor string) bool {
+	return hasCommaValue(ci.Editor, editor)
+}
+
 type AgeRating string
 
 const (
@@ -168,25 +751,258 @@ func (ag AgeRating) IsValid() bool {
 	}
 }
 
+// ageRatings lists all the valid AgeRating values.
+var ageRatings = []AgeRating{
+	AgeRatingUnknown, AgeRatingAdultsOnly18Plus, AgeRatingEarlyChildhood, AgeRatingEveryone,
+	AgeRatingEveryone10Plus, AgeRatingG, AgeRatingKidsToAdults, AgeRatingM, AgeRatingMA15Plus,
+	AgeRatingMature17Plus, AgeRatingPG, AgeRatingR18Plus, AgeRatingRatingPending, AgeRatingTeen,
+	AgeRatingX18Plus,
+}
+
+// canonical returns the valid AgeRating matching ag case-insensitively, eg. "adults only 18+" becomes AgeRatingAdultsOnly18Plus.
+// The boolean is false if ag does not match any of them.
+func (ag AgeRating) canonical() (AgeRating, bool) {
+	value := strings.TrimSpace(string(ag))
+	if value == "" {
+		return "", true
+	}
+	for _, rating := range ageRatings {
+		if strings.EqualFold(value, string(rating)) {
+			return rating, true
+		}
+	}
+	return ag, false
+}
+
+// MarshalXML implements the xml.Marshaler interface, writing the canonical form of the rating.
+func (ag AgeRating) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	canonical, _ := ag.canonical()
+	return e.EncodeElement(string(canonical), start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface. Values are matched case-insensitively against the valid ratings
+// and stored in their canonical form, an error is returned if the value does not match any of them.
+func (ag *AgeRating) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var value string
+	if err := d.DecodeElement(&value, &start); err != nil {
+		return err
+	}
+	canonical, ok := AgeRating(value).canonical()
+	if !ok {
+		return fmt.Errorf("unknown AgeRating value %q", value)
+	}
+	*ag = canonical
+	return nil
+}
+
+// ageRatingLevels defines the partial order used by Supersedes: the higher the level, the more restrictive the rating.
+// Ratings sharing a level are considered equivalent. Unrated values (empty, Unknown, Rating Pending) are at level 0.
+var ageRatingLevels = map[AgeRating]int{
+	AgeRatingEarlyChildhood:   1,
+	AgeRatingEveryone:         2,
+	AgeRatingG:                2,
+	AgeRatingKidsToAdults:     2,
+	AgeRatingEveryone10Plus:   3,
+	AgeRatingPG:               3,
+	AgeRatingTeen:             4,
+	AgeRatingM:                5,
+	AgeRatingMA15Plus:         6,
+	AgeRatingMature17Plus:     7,
+	AgeRatingAdultsOnly18Plus: 8,
+	AgeRatingR18Plus:          8,
+	AgeRatingX18Plus:          9,
+}
+
+// Supersedes returns true if ag is strictly more restrictive than other, eg. Mature 17+ supersedes Teen.
+// Any rating supersedes an unrated value, and equivalent ratings (eg. "Adults Only 18+" and "R18+") do not supersede each other.
+func (ag AgeRating) Supersedes(other AgeRating) bool {
+	return ageRatingLevels[ag] > ageRatingLevels[other]
+}
+
+// MostRestrictiveAgeRating returns the most restrictive of the given ratings, the first one winning among equivalent ratings.
+// It returns an empty AgeRating if no ratings are given.
+func MostRestrictiveAgeRating(ratings ...AgeRating) (most AgeRating) {
+	for index, rating := range ratings {
+		if index == 0 || rating.Supersedes(most) {
+			most = rating
+		}
+	}
+	return
+}
+
 type PagesV2 struct {
-	Pages []PageV2 `xml:"Page"`
+	Pages []PageV2 `xml:"Page" json:"Page"`
 }
 
 func (ps PagesV2) Validate() (err error) {
+	return ps.validate(nil)
+}
+
+// ValidateParallel is Validate with the pages checked concurrently, which is faster for large lists (eg. manga volumes).
+// It returns the same error as Validate would.
+func (ps PagesV2) ValidateParallel() error {
+	pageErrs := make([]error, len(ps.Pages))
+	workers := min(runtime.GOMAXPROCS(0), len(ps.Pages))
+	var wg sync.WaitGroup
+	for worker := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := worker; i < len(ps.Pages); i += workers {
+				pageErrs[i] = ps.Pages[i].Validate()
+			}
+		}()
+	}
+	wg.Wait()
+	return ps.validate(pageErrs)
+}
+
+// validate runs the list checks. pageErrs, if not nil, holds the result of each page Validate() computed beforehand.
+func (ps PagesV2) validate(pageErrs []error) (err error) {
 	keys := make(map[string]struct{}, len(ps.Pages))
+	covers := make(map[PageType]int, 2)
 	var ok bool
 	for i, p := range ps.Pages {
+		if p.Key == "" {
+			return fmt.Errorf("page %d: Key must not be empty", i+1)
+		}
 		if _, ok = keys[p.Key]; ok {
 			return fmt.Errorf("duplicate key found for page %d: %q", i+1, p.Key)
 		}
 		keys[p.Key] = struct{}{}
-		if err = p.Validate(); err != nil {
+		if pageErrs != nil {
+			err = pageErrs[i]
+		} else {
+			err = p.Validate()
+		}
+		if err != nil {
 			return fmt.Errorf("failed to validate page %d: %w", i+1, err)
 		}
+		if p.Type == PageTypeFrontCover || p.Type == PageTypeBackCover {
+			if previous, ok := covers[p.Type]; ok {
+				return fmt.Errorf("multiple %s pages found: indices %d and %d", p.Type, previous, p.Image)
+			}
+			covers[p.Type] = p.Image
+		}
+	}
+	return
+}
+
+// ValidatePagesV2 checks a standalone pages list, for example before attaching it to a ComicInfov2. See PagesV2.Validate().
+func ValidatePagesV2(ps PagesV2) error {
+	return ps.Validate()
+}
+
+// ToV1 converts the pages to the v1 pages list. The Bookmark of the pages is dropped as v1 does not support it.
+func (ps PagesV2) ToV1() Pages {
+	if ps.Pages == nil {
+		return nil
+	}
+	converted := make(Pages, len(ps.Pages))
+	for i, p := range ps.Pages {
+		converted[i] = Page{
+			Image:       p.Image,
+			Type:        p.Type,
+			DoublePage:  p.DoublePage,
+			ImageSize:   p.ImageSize,
+			Key:         p.Key,
+			ImageWidth:  p.ImageWidth,
+			ImageHeight: p.ImageHeight,
+		}
+	}
+	return converted
+}
+
+// FrontCoverIndex returns the Image index of the front cover page or -1 if there is none.
+func (ps PagesV2) FrontCoverIndex() int {
+	return ps.indexOfType(PageTypeFrontCover)
+}
+
+// BackCoverIndex returns the Image index of the back cover page or -1 if there is none.
+func (ps PagesV2) BackCoverIndex() int {
+	return ps.indexOfType(PageTypeBackCover)
+}
+
+// HasDoublePage returns true if at least one page is flagged as a double page spread.
+func (ps PagesV2) HasDoublePage() bool {
+	for _, p := range ps.Pages {
+		if p.DoublePage {
+			return true
+		}
+	}
+	return false
+}
+
+// DoublePageIndices returns the Image indexes of the pages flagged as double page spreads.
+func (ps PagesV2) DoublePageIndices() (indices []int) {
+	for _, p := range ps.Pages {
+		if p.DoublePage {
+			indices = append(indices, p.Image)
+		}
 	}
 	return
 }
 
+func (ps PagesV2) indexOfType(pt PageType) int {
+	for _, p := range ps.Pages {
+		if p.Type == pt {
+			return p.Image
+		}
+	}
+	return -1
+}
+
+// Count returns the number of pages within the list. It may differ from the informational PageCount field.
+func (ps PagesV2) Count() int {
+	return len(ps.Pages)
+}
+
+// AutoAssignIndices sets the Image index of every page to its position within the list, overwriting existing values.
+func (ps *PagesV2) AutoAssignIndices() {
+	for i := range ps.Pages {
+		ps.Pages[i].Image = i
+	}
+}
+
+// AutoAssignKeys sets the Key of every page without one to "p%04d.jpg" formatted with its Image index, eg. "p0003.jpg".
+// Pages with a Key already set are left untouched.
+func (ps *PagesV2) AutoAssignKeys() {
+	for i := range ps.Pages {
+		if ps.Pages[i].Key == "" {
+			ps.Pages[i].Key = fmt.Sprintf("p%04d.jpg", ps.Pages[i].Image)
+		}
+	}
+}
+
+// ValidateSequential checks that the pages Image indexes form a contiguous sequence starting at 0.
+// This is a stricter check than Validate() as some readers skip or mis-render pages when indexes have gaps.
+func (ps PagesV2) ValidateSequential() error {
+	indexes := make(map[int]struct{}, len(ps.Pages))
+	for _, p := range ps.Pages {
+		indexes[p.Image] = struct{}{}
+	}
+	for i := range len(ps.Pages) {
+		if _, found := indexes[i]; !found {
+			return fmt.Errorf("page indices are not sequential: missing index %d", i)
+		}
+	}
+	return nil
+}
+
+// AutoPopulatePageInfo decodes the image header of data and sets the ImageWidth, ImageHeight and ImageSize
+// of the page at position pageIndex within the list. JPEG, PNG and GIF images are supported. WebP is not supported
+// out of the box as the standard library has no WebP decoder: blank import golang.org/x/image/webp to register it.
+func (ps *PagesV2) AutoPopulatePageInfo(data []byte, pageIndex int) error {
+	if pageIndex < 0 || pageIndex >= len(ps.Pages) {
+		return fmt.Errorf("page index %d out of range [0:%d]", pageIndex, len(ps.Pages))
+	}
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to decode image of page %d: %w", pageIndex, err)
+	}
+	return ps.Pages[pageIndex].SetDimensions(config.Width, config.Height, len(data))
+}
+
 type PageV2 struct {
 	Image       int      `xml:"Image,attr"`
 	Type        PageType `xml:"Type,attr"`
@@ -198,8 +1014,60 @@ type PageV2 struct {
 	ImageHeight int      `xml:"ImageHeight,attr"`
 }
 
+// NewPageV2 returns a page with unknown (-1) image dimensions, which unlike the zero value passes validation.
+func NewPageV2(imageIndex int, key string, pt PageType) PageV2 {
+	return PageV2{
+		Image:       imageIndex,
+		Type:        pt,
+		Key:         key,
+		ImageWidth:  -1,
+		ImageHeight: -1,
+	}
+}
+
+// SetDimensions sets the image width and height (-1 if unknown) and the image size in bytes (0 if unknown) of the page.
+func (p *PageV2) SetDimensions(width, height, sizeBytes int) error {
+	if !(width > 0 || width == -1) {
+		return errors.New("image width must be greater than 0 or -1")
+	}
+	if !(height > 0 || height == -1) {
+		return errors.New("image height must be greater than 0 or -1")
+	}
+	if sizeBytes < 0 {
+		return errors.New("image size must be positive")
+	}
+	p.ImageWidth = width
+	p.ImageHeight = height
+	p.ImageSize = sizeBytes
+	return nil
+}
+
+// AspectRatio returns the width to height ratio of the image or 0 if any of the dimensions is unknown.
+func (p PageV2) AspectRatio() float64 {
+	if p.ImageWidth <= 0 || p.ImageHeight <= 0 {
+		return 0
+	}
+	return float64(p.ImageWidth) / float64(p.ImageHeight)
+}
+
+// IsLandscape returns true if the image is wider than high, which usually denotes a double page spread.
+func (p PageV2) IsLandscape() bool {
+	return p.AspectRatio() > 1
+}
+
+// IsPortrait returns true if the image is not wider than high. It returns false if the dimensions are unknown.
+func (p PageV2) IsPortrait() bool {
+	ratio := p.AspectRatio()
+	return ratio > 0 && ratio <= 1
+}
+
+// String returns a short description of the page, eg "Page 0 (FrontCover) 1280x1920 [cover.jpg]".
+func (p PageV2) String() string {
+	return fmt.Sprintf("Page %d (%s) %dx%d [%s]", p.Image, p.Type, p.ImageWidth, p.ImageHeight, p.Key)
+}
+
 func (p *PageV2) Validate() (err error) {
-	if !p.Type.Valid() {
+	if !p.Type.IsValid() {
 		return fmt.Errorf("invalid page type: %q", p.Type)
 	}
 	if !(p.ImageWidth > 0 || p.ImageWidth == -1) {
@@ -208,11 +1076,51 @@ func (p *PageV2) Validate() (err error) {
 	if !(p.ImageHeight > 0 || p.ImageHeight == -1) {
 		return errors.New("image height must be greater than 0 or -1")
 	}
+	if p.ImageSize < 0 {
+		return errors.New("image size must be positive")
+	}
 	return
 }
 
 type CommunityRating float64
 
+// Add returns a new rating equal to cr + delta, rounded to 2 digits. Results slightly out of the 0-5 range
+// (by less than the 0.01 precision) are clamped, an error is returned if clamping would lose more than that.
+func (cr CommunityRating) Add(delta float64) (*CommunityRating, error) {
+	const (
+		precision = 100
+		tolerance = 1.0 / precision
+	)
+	result := math.Round((float64(cr)+delta)*precision) / precision
+	switch {
+	case result < 0:
+		if -result > tolerance {
+			return nil, fmt.Errorf("resulting rating %.2f is below 0", result)
+		}
+		result = 0
+	case result > 5:
+		if result-5 > tolerance {
+			return nil, fmt.Errorf("resulting rating %.2f is above 5", result)
+		}
+		result = 5
+	}
+	rating := CommunityRating(result)
+	return &rating, nil
+}
+
+// Subtract returns a new rating equal to cr - delta, with the same rounding and clamping rules as Add.
+func (cr CommunityRating) Subtract(delta float64) (*CommunityRating, error) {
+	return cr.Add(-delta)
+}
+
+// String returns the rating formatted as "3.75/5.00" or "unrated" if nil.
+func (cr *CommunityRating) String() string {
+	if cr == nil {
+		return "unrated"
+	}
+	return fmt.Sprintf("%.2f/5.00", float64(*cr))
+}
+
 func (cr *CommunityRating) IsValid() bool {
 	if cr == nil {
 		return true
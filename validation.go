@@ -0,0 +1,76 @@
+package comicinfo
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ValidationOptions allows to opt into stricter checks than the ones performed by Validate().
+// Its zero value performs the same checks as Validate().
+type ValidationOptions struct {
+	StrictPageSequence bool // pages Image indexes must form a contiguous sequence starting at 0
+	RequirePublisher   bool // Publisher must be set
+	StrictFormat       bool // Format, if set, must be one of the commonly used designators (see KnownFormats)
+	StrictWebScheme    bool // every URL of the Web field must be an absolute http or https URL
+	// ParallelPageValidation validates the pages concurrently, see PagesV2.ValidateParallel(). The checks are the same.
+	ParallelPageValidation bool
+}
+
+// KnownFormats lists the commonly used Format designators, checked (case-insensitively) by ValidationOptions.StrictFormat.
+var KnownFormats = []string{
+	"Annotation", "Annual", "Anthology", "Black & White", "Box-Set", "Crossover", "Digital", "Director's Cut",
+	"Giant", "Graphic Novel", "HC", "Hardcover", "Limited Series", "Magazine", "Omnibus", "One-Shot", "Preview",
+	"Prologue", "Reprint", "Series", "Special", "TBP", "TPB", "Trade Paperback", "Web",
+}
+
+// ValidateWithOptions runs Validate() and then the additional checks enabled within opts.
+func (ci ComicInfov2) ValidateWithOptions(opts ValidationOptions) (err error) {
+	if err = errors.Join(ci.validateAll(opts.ParallelPageValidation)...); err != nil {
+		return
+	}
+	if opts.StrictPageSequence {
+		if err = ci.Pages.ValidateSequential(); err != nil {
+			return fmt.Errorf("failed to validate Pages: %w", err)
+		}
+	}
+	if opts.RequirePublisher && strings.TrimSpace(ci.Publisher) == "" {
+		return errors.New("failed to validate Publisher: value is required")
+	}
+	if opts.StrictFormat && ci.Format != "" && !isKnownFormat(ci.Format) {
+		return fmt.Errorf("failed to validate Format: unknown value %q", ci.Format)
+	}
+	if opts.StrictWebScheme {
+		if err = validateWebSchemes(ci.Web); err != nil {
+			return fmt.Errorf("failed to validate Web: %w", err)
+		}
+	}
+	return
+}
+
+func isKnownFormat(format string) bool {
+	for _, known := range KnownFormats {
+		if strings.EqualFold(known, format) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateWebSchemes checks that every URL of a Web field is an absolute http or https URL.
+func validateWebSchemes(web string) error {
+	for index, token := range strings.Fields(web) {
+		u, err := url.Parse(token)
+		if err != nil {
+			return fmt.Errorf("URL #%d: %w", index, err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("URL #%d must use the http or https scheme: %q", index, token)
+		}
+		if u.Host == "" {
+			return fmt.Errorf("URL #%d has no host: %q", index, token)
+		}
+	}
+	return nil
+}
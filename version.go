@@ -0,0 +1,107 @@
+package comicinfo
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Version identifies a ComicInfo schema version.
+type Version int
+
+const (
+	V1  Version = iota + 1 // ComicInfo v1.0, see ComicInfov1
+	V2                     // ComicInfo v2.0, see ComicInfov2
+	V21                    // ComicInfo v2.1 DRAFT, see ComicInfov21
+)
+
+// ListVersions returns all the supported versions, from the oldest to the newest.
+func ListVersions() []Version {
+	return []Version{V1, V2, V21}
+}
+
+// String returns the version number, eg. "2.0".
+func (v Version) String() string {
+	switch v {
+	case V1:
+		return "1.0"
+	case V2:
+		return "2.0"
+	case V21:
+		return "2.1"
+	default:
+		return fmt.Sprintf("Version(%d)", int(v))
+	}
+}
+
+// SchemaURL returns the schema location written by the Encode() method of the version, or an empty string for an unknown version.
+func (v Version) SchemaURL() string {
+	switch v {
+	case V1:
+		return v1SchemaLocationURL
+	case V2:
+		return v2SchemaLocationURL
+	case V21:
+		return v21SchemaLocationURL
+	default:
+		return ""
+	}
+}
+
+// IsDraft returns true if the version schema is not final yet, which is only the case of V21.
+func (v Version) IsDraft() bool {
+	return v == V21
+}
+
+// ErrVersionUndetected is returned by DetectVersion when the schema location is missing or unknown.
+var ErrVersionUndetected = errors.New("ComicInfo version could not be detected")
+
+// DetectVersion reads the stream until the root element and identifies the ComicInfo version from its
+// xsi:schemaLocation attribute. If the attribute is missing or its URL unrecognized, V2 is returned along with
+// ErrVersionUndetected. Only the beginning of the stream is consumed.
+func DetectVersion(r io.Reader) (Version, error) {
+	if r == nil {
+		return V2, errors.New("input cannot be nil")
+	}
+	decoder := xml.NewDecoder(r)
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return V2, fmt.Errorf("failed to find the root element: %w", err)
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		if start.Name.Local != "ComicInfo" {
+			return V2, fmt.Errorf("unexpected root element %q", start.Name.Local)
+		}
+		for _, attr := range start.Attr {
+			if attr.Name.Local != "schemaLocation" {
+				continue
+			}
+			// schemaLocation may also be a list of namespace and URL pairs
+			for _, location := range strings.Fields(attr.Value) {
+				if version, found := versionFromSchemaURL(location); found {
+					return version, nil
+				}
+			}
+		}
+		return V2, ErrVersionUndetected
+	}
+}
+
+func versionFromSchemaURL(location string) (Version, bool) {
+	switch {
+	case location == v1SchemaLocationURL || strings.Contains(location, "/v1.0/"):
+		return V1, true
+	case location == v2SchemaLocationURL || strings.Contains(location, "/v2.0/"):
+		return V2, true
+	case location == v21SchemaLocationURL || strings.Contains(location, "/v2.1/"):
+		return V21, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,111 @@
+package comicinfo
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// webURLCount returns the number of space separated URLs within a Web field.
+func webURLCount(web string) int {
+	return len(strings.Fields(web))
+}
+
+// firstWebURL parses and returns the first URL of a Web field.
+func firstWebURL(web string) (*url.URL, error) {
+	tokens := strings.Fields(web)
+	if len(tokens) == 0 {
+		return nil, errors.New("Web field is empty")
+	}
+	u, err := url.Parse(tokens[0])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse first URL: %w", err)
+	}
+	return u, nil
+}
+
+// webURLKey returns the comparison key of a URL: scheme, host and path are lowercased, query and fragment are kept as is.
+func webURLKey(u *url.URL) string {
+	normalized := *u
+	normalized.Scheme = strings.ToLower(u.Scheme)
+	normalized.Host = strings.ToLower(u.Host)
+	normalized.Path = strings.ToLower(u.Path)
+	normalized.RawPath = ""
+	return normalized.String()
+}
+
+// hasWebURL returns true if u is present within the Web field. Unparseable tokens are ignored.
+func hasWebURL(web string, u *url.URL) bool {
+	if u == nil {
+		return false
+	}
+	key := webURLKey(u)
+	for _, token := range strings.Fields(web) {
+		existing, err := url.Parse(token)
+		if err != nil {
+			continue
+		}
+		if webURLKey(existing) == key {
+			return true
+		}
+	}
+	return false
+}
+
+// addWebURL appends u to the Web field if not already present.
+func addWebURL(web *string, u *url.URL) {
+	if u == nil || hasWebURL(*web, u) {
+		return
+	}
+	*web = strings.Join(append(strings.Fields(*web), u.String()), " ")
+}
+
+// formatWebURLs returns the Web field value for urls: their String() form (which percent-encodes spaces) joined by spaces.
+// Nil URLs are skipped.
+func formatWebURLs(urls []*url.URL) string {
+	tokens := make([]string, 0, len(urls))
+	for _, u := range urls {
+		if u != nil {
+			tokens = append(tokens, u.String())
+		}
+	}
+	return strings.Join(tokens, " ")
+}
+
+// validateWebURLs checks every URL of a Web field. As URLs are space separated, a token without a scheme following a URL
+// is reported as an unencoded space within that URL, eg. "https://example.com/my comic".
+func validateWebURLs(web string) (errs []error) {
+	owner, reported := -1, -1 // index of the last URL with a scheme and of the last one reported
+	for index, token := range strings.Split(web, " ") {
+		u, err := url.Parse(token)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to validate URL #%d: %w", index, err))
+			continue
+		}
+		switch {
+		case token == "":
+		case u.Scheme != "":
+			owner = index
+		case owner != -1 && owner != reported:
+			errs = append(errs, fmt.Errorf("URL #%d contains unencoded space; use %%20 or call NormalizeWebField() first", owner))
+			reported = owner
+		}
+	}
+	return
+}
+
+// NormalizeWebField returns web with the spaces within its URLs encoded as %20: tokens without a scheme are considered
+// to be part of the preceding URL, eg. "https://example.com/my comic https://example.org" becomes
+// "https://example.com/my%20comic https://example.org". Extra whitespace between URLs is removed.
+func NormalizeWebField(web string) string {
+	var tokens []string
+	for _, token := range strings.Fields(web) {
+		if u, err := url.Parse(token); len(tokens) > 0 && (err != nil || u.Scheme == "") {
+			tokens[len(tokens)-1] += "%20" + token
+			continue
+		}
+		tokens = append(tokens, token)
+	}
+	return strings.Join(tokens, " ")
+}